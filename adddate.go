@@ -0,0 +1,80 @@
+package timeinterval
+
+import "time"
+
+// MonthEndPolicy handling of a day of month missing in the target month (January 31 plus one month).
+type MonthEndPolicy int
+
+const (
+	// MonthEndClamp moving the day to the last day of the target month, January 31 becomes February 28/29 (default).
+	MonthEndClamp MonthEndPolicy = iota
+	// MonthEndOverflow normalizing the day the same way as time.AddDate, January 31 becomes March 2/3.
+	MonthEndOverflow
+)
+
+// AddDate time interval with both boundaries moved by the years, months and days
+// in their locations, the wall clock is kept. Returns an error if the boundaries become invalid,
+// for example January 30 - January 31 plus one month with MonthEndClamp.
+
+// policy - handling of a day missing in the target month, MonthEndClamp by default.
+func (s *Span) AddDate(years, months, days int, policy ...MonthEndPolicy) (Span, error) {
+	p := monthEndPolicy(policy)
+	return New(addDate(s.start, years, months, days, p), addDate(s.end, years, months, days, p))
+}
+
+// ShiftCalendar time interval starting the years, months and days later in the location of the start
+// and having the same duration.
+
+// policy - handling of a day missing in the target month, MonthEndClamp by default.
+func (s *Span) ShiftCalendar(years, months, days int, policy ...MonthEndPolicy) Span {
+	start := addDate(s.start, years, months, days, monthEndPolicy(policy))
+	return Span{
+		start: start,
+		end:   start.Add(s.Duration()),
+	}
+}
+
+// CalendarLength length of the time interval in whole years, months and days counted from the start
+// in its location (with MonthEndClamp) and the remainder shorter than a day.
+// A person born on February 29 is one year old on February 28 of the next year.
+func (s *Span) CalendarLength() (years, months, days int, rem time.Duration) {
+	if !s.start.Before(s.end) {
+		return 0, 0, 0, 0
+	}
+	start, end := s.start, s.end.In(s.start.Location())
+	total := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+	for total > 0 && addDate(start, 0, total, 0, MonthEndClamp).After(end) {
+		total--
+	}
+	anchor := addDate(start, 0, total, 0, MonthEndClamp)
+	days = int(end.Sub(anchor) / dayLength)
+	for days > 0 && addDate(anchor, 0, 0, days, MonthEndClamp).After(end) {
+		days--
+	}
+	for !addDate(anchor, 0, 0, days+1, MonthEndClamp).After(end) {
+		days++
+	}
+	return total / 12, total % 12, days, end.Sub(addDate(anchor, 0, 0, days, MonthEndClamp))
+}
+
+// addDate t moved by the years, months and days in its location with the wall clock kept.
+func addDate(t time.Time, years, months, days int, policy MonthEndPolicy) time.Time {
+	if policy == MonthEndOverflow {
+		return t.AddDate(years, months, days)
+	}
+	year, month, day := t.Date()
+	// the day 0 of the next month is the last day of the target month
+	last := time.Date(year+years, month+time.Month(months)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day > last {
+		day = last
+	}
+	return atClock(year+years, month+time.Month(months), day+days, clockOf(t), t.Location())
+}
+
+// monthEndPolicy optional MonthEndPolicy, MonthEndClamp by default.
+func monthEndPolicy(policy []MonthEndPolicy) MonthEndPolicy {
+	if len(policy) > 0 {
+		return policy[0]
+	}
+	return MonthEndClamp
+}
@@ -0,0 +1,143 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpanAddDate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		s      Span
+		years  int
+		months int
+		days   int
+		policy MonthEndPolicy
+
+		excepted      Span
+		exceptedShift Span
+		exceptedErr   error
+	}{
+		{
+			name:          "month_clamp",
+			s:             dateSpan(2024, time.January, 31, 2024, time.February, 2),
+			months:        1,
+			excepted:      dateSpan(2024, time.February, 29, 2024, time.March, 2),
+			exceptedShift: dateSpan(2024, time.February, 29, 2024, time.March, 2),
+		},
+		{
+			name:          "month_overflow",
+			s:             dateSpan(2024, time.January, 31, 2024, time.February, 2),
+			months:        1,
+			policy:        MonthEndOverflow,
+			excepted:      dateSpan(2024, time.March, 2, 2024, time.March, 2),
+			exceptedShift: dateSpan(2024, time.March, 2, 2024, time.March, 4),
+			exceptedErr:   ErrEmpty,
+		},
+		{
+			name:          "leap_year_clamp",
+			s:             dateSpan(2024, time.February, 29, 2024, time.March, 31),
+			years:         1,
+			excepted:      dateSpan(2025, time.February, 28, 2025, time.March, 31),
+			exceptedShift: dateSpan(2025, time.February, 28, 2025, time.March, 31),
+		},
+		{
+			name:          "collapsed_by_clamp",
+			s:             dateSpan(2023, time.January, 30, 2023, time.January, 31),
+			months:        1,
+			excepted:      dateSpan(2023, time.February, 28, 2023, time.February, 28),
+			exceptedShift: dateSpan(2023, time.February, 28, 2023, time.March, 1),
+			exceptedErr:   ErrEmpty,
+		},
+		{
+			name:          "backwards",
+			s:             dateSpan(2024, time.March, 31, 2024, time.April, 30),
+			months:        -1,
+			days:          1,
+			excepted:      dateSpan(2024, time.March, 1, 2024, time.March, 31),
+			exceptedShift: dateSpan(2024, time.March, 1, 2024, time.March, 31),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.s.AddDate(tc.years, tc.months, tc.days, tc.policy)
+			if tc.exceptedErr != nil {
+				assert.True(t, errors.Is(err, tc.exceptedErr))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.excepted, result)
+			}
+			assert.Equal(t, tc.exceptedShift, tc.s.ShiftCalendar(tc.years, tc.months, tc.days, tc.policy))
+		})
+	}
+}
+
+func TestSpanAddDateWallClock(t *testing.T) {
+	newYork := loadLocation(t, "America/New_York")
+	s := Span{
+		start: time.Date(2024, time.March, 9, 9, 0, 0, 0, newYork),
+		end:   time.Date(2024, time.March, 9, 17, 0, 0, 0, newYork),
+	}
+	result, err := s.AddDate(0, 0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 10, 9, 0, 0, 0, newYork), result.Start())
+	assert.Equal(t, time.Date(2024, time.March, 10, 17, 0, 0, 0, newYork), result.End())
+}
+
+func TestSpanCalendarLength(t *testing.T) {
+	testCases := []struct {
+		name string
+		s    Span
+
+		exceptedYears  int
+		exceptedMonths int
+		exceptedDays   int
+		exceptedRem    time.Duration
+	}{
+		{
+			name:           "contract",
+			s:              dateSpan(2023, time.March, 15, 2025, time.June, 20),
+			exceptedYears:  2,
+			exceptedMonths: 3,
+			exceptedDays:   5,
+		},
+		{
+			name:          "leap_birthday",
+			s:             dateSpan(2024, time.February, 29, 2025, time.February, 28),
+			exceptedYears: 1,
+		},
+		{
+			name:           "month_end",
+			s:              dateSpan(2024, time.January, 31, 2024, time.March, 1),
+			exceptedMonths: 1,
+			exceptedDays:   1,
+		},
+		{
+			name: "remainder",
+			s: Span{
+				start: time.Date(2024, time.January, 10, 18, 0, 0, 0, time.UTC),
+				end:   time.Date(2024, time.February, 10, 12, 30, 0, 0, time.UTC),
+			},
+			exceptedDays: 30,
+			exceptedRem:  18*time.Hour + 30*time.Minute,
+		},
+		{
+			name: "empty",
+			s:    Span{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			years, months, days, rem := tc.s.CalendarLength()
+			assert.Equal(t, tc.exceptedYears, years)
+			assert.Equal(t, tc.exceptedMonths, months)
+			assert.Equal(t, tc.exceptedDays, days)
+			assert.Equal(t, tc.exceptedRem, rem)
+		})
+	}
+}
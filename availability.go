@@ -0,0 +1,90 @@
+package timeinterval
+
+import (
+	"math"
+	"time"
+)
+
+// AvailabilityReport availability of a service within the reporting window.
+type AvailabilityReport struct {
+	Window Span
+	// Monitored time of the window without maintenance.
+	Monitored time.Duration
+	// Downtime total time of outages outside of maintenance.
+	Downtime time.Duration
+	// Incidents number of outages after merging the overlapping ones.
+	Incidents int
+	// Availability percentage of the monitored time when the service was up.
+	Availability float64
+	// MTTR mean time to recovery, zero if there were no incidents.
+	MTTR time.Duration
+	// MTBF mean time between failures (uptime per incident), zero if there were no incidents.
+	MTBF time.Duration
+}
+
+// Availability availability report of outages within the window.
+// Overlapping outages are merged before counting, the time of maintenance is excluded
+// both from the monitored time and from the outages.
+func Availability(outages SpanMany, window Span, maintenance ...SpanMany) AvailabilityReport {
+	report := AvailabilityReport{
+		Window:       window,
+		Availability: 100,
+	}
+	if !window.start.Before(window.end) {
+		return report
+	}
+	monitored := NewMany(window)
+	for _, m := range maintenance {
+		monitored = monitored.exceptMany(m)
+	}
+	report.Monitored = monitored.Duration()
+
+	merged := outages.normalized()
+	for _, outage := range merged.spans {
+		down := monitored.Intersection(outage)
+		if d := down.Duration(); d > 0 {
+			report.Incidents++
+			report.Downtime += d
+		}
+	}
+	if report.Monitored > 0 {
+		report.Availability = 100 * float64(report.Monitored-report.Downtime) / float64(report.Monitored)
+	}
+	if report.Incidents > 0 {
+		report.MTTR = report.Downtime / time.Duration(report.Incidents)
+		report.MTBF = (report.Monitored - report.Downtime) / time.Duration(report.Incidents)
+	}
+	return report
+}
+
+// AvailabilityByUnit availability reports for every calendar unit (day, month...) in the location
+// touching the window, each unit is clipped to the window.
+// An outage crossing the border of units is counted as an incident in both of them.
+func AvailabilityByUnit(outages SpanMany, window Span, unit Unit, loc *time.Location, maintenance ...SpanMany) []AvailabilityReport {
+	var result []AvailabilityReport
+	if !window.start.Before(window.end) {
+		return result
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	for start := unitStart(window.start, unit, loc); start.Before(window.end); start = unitAdd(start, unit, 1) {
+		period := window.Intersection(Span{start: start, end: unitAdd(start, unit, 1)})
+		result = append(result, Availability(outages, period, maintenance...))
+	}
+	return result
+}
+
+// BudgetBurn share of the error budget spent for the availability target in percent (for example 99.9).
+// 1 means the budget is exactly exhausted, more than 1 - the target is missed,
+// +Inf for the 100 percent target with any downtime.
+func (r *AvailabilityReport) BudgetBurn(target float64) float64 {
+	budget := float64(r.Monitored) * (100 - target) / 100
+	if budget <= 0 {
+		if r.Downtime > 0 {
+			return math.Inf(1)
+		}
+		return 0
+	}
+	return float64(r.Downtime) / budget
+}
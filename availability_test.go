@@ -0,0 +1,140 @@
+package timeinterval
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailability(t *testing.T) {
+	outages := NewMany(
+		Span{
+			time.Date(2020, 10, 5, 10, 30, 0, 0, time.UTC),
+			time.Date(2020, 10, 5, 11, 30, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 10, 31, 23, 0, 0, 0, time.UTC),
+			time.Date(2020, 11, 1, 1, 0, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 10, 5, 10, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 5, 11, 0, 0, 0, time.UTC)},
+	)
+	maintenance := NewMany(Span{
+		time.Date(2020, 10, 5, 11, 0, 0, 0, time.UTC),
+		time.Date(2020, 10, 5, 12, 0, 0, 0, time.UTC),
+	})
+	window := Span{
+		time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC),
+	}
+	testCases := []struct {
+		name        string
+		outages     SpanMany
+		window      Span
+		maintenance []SpanMany
+
+		excepted AvailabilityReport
+	}{
+		{
+			name:        "maintenance",
+			outages:     outages,
+			window:      window,
+			maintenance: []SpanMany{maintenance},
+			excepted: AvailabilityReport{
+				Window:       window,
+				Monitored:    743 * time.Hour,
+				Downtime:     2 * time.Hour,
+				Incidents:    2,
+				Availability: 100 * 741.0 / 743.0,
+				MTTR:         time.Hour,
+				MTBF:         741 * time.Hour / 2,
+			},
+		},
+		{
+			name:    "without_maintenance",
+			outages: outages,
+			window:  window,
+			excepted: AvailabilityReport{
+				Window:       window,
+				Monitored:    744 * time.Hour,
+				Downtime:     150 * time.Minute,
+				Incidents:    2,
+				Availability: 100 * (744 - 2.5) / 744,
+				MTTR:         75 * time.Minute,
+				MTBF:         (744*time.Hour - 150*time.Minute) / 2,
+			},
+		},
+		{
+			name:    "no_outages",
+			outages: NewMany(),
+			window:  window,
+			excepted: AvailabilityReport{
+				Window:       window,
+				Monitored:    744 * time.Hour,
+				Availability: 100,
+			},
+		},
+		{
+			name:    "empty_window",
+			outages: outages,
+			excepted: AvailabilityReport{
+				Availability: 100,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := Availability(tc.outages, tc.window, tc.maintenance...)
+			assert.InDelta(t, tc.excepted.Availability, result.Availability, 1e-9)
+			result.Availability = tc.excepted.Availability
+			assert.Equal(t, tc.excepted, result)
+		})
+	}
+}
+
+func TestAvailabilityByUnit(t *testing.T) {
+	outages := NewMany(Span{
+		time.Date(2020, 10, 31, 23, 0, 0, 0, time.UTC),
+		time.Date(2020, 11, 1, 1, 0, 0, 0, time.UTC),
+	})
+	window := Span{
+		time.Date(2020, 9, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 11, 15, 0, 0, 0, 0, time.UTC),
+	}
+	result := AvailabilityByUnit(outages, window, UnitMonth, time.UTC)
+	assert.Len(t, result, 3)
+
+	assert.Equal(t, Span{
+		time.Date(2020, 9, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC),
+	}, result[0].Window)
+	assert.Equal(t, 0, result[0].Incidents)
+	assert.Equal(t, 16*24*time.Hour, result[0].Monitored)
+
+	assert.Equal(t, 1, result[1].Incidents)
+	assert.Equal(t, time.Hour, result[1].Downtime)
+	assert.Equal(t, 744*time.Hour, result[1].Monitored)
+
+	assert.Equal(t, Span{
+		time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 11, 15, 0, 0, 0, 0, time.UTC),
+	}, result[2].Window)
+	assert.Equal(t, 1, result[2].Incidents)
+	assert.Equal(t, time.Hour, result[2].Downtime)
+
+	assert.Empty(t, AvailabilityByUnit(outages, Span{}, UnitDay, nil))
+}
+
+func TestAvailabilityReportBudgetBurn(t *testing.T) {
+	report := AvailabilityReport{
+		Monitored: 1000 * time.Hour,
+		Downtime:  30 * time.Minute,
+	}
+	assert.InDelta(t, 0.5, report.BudgetBurn(99.9), 1e-9)
+	assert.InDelta(t, 2.5, report.BudgetBurn(99.98), 1e-9)
+	assert.True(t, math.IsInf(report.BudgetBurn(100), 1))
+	report.Downtime = 0
+	assert.Equal(t, 0.0, report.BudgetBurn(100))
+}
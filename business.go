@@ -0,0 +1,99 @@
+package timeinterval
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// businessSearchStep size of the window materialized at once when searching working time forward.
+	businessSearchStep = 4 * weekLength
+	// businessSearchLimit how far working time is searched forward, 130 windows of 4 weeks (about 10 years).
+	businessSearchLimit = 130 * businessSearchStep
+)
+
+// BusinessCalendar working time defined by weekly working hours minus holidays.
+type BusinessCalendar struct {
+	hours    WeeklyTemplate
+	loc      *time.Location
+	holidays SpanMany
+}
+
+// NewBusinessCalendar initialization of a new BusinessCalendar.
+// Working hours are taken in the location, holidays are excluded from the working time.
+func NewBusinessCalendar(hours WeeklyTemplate, loc *time.Location, holidays SpanMany) BusinessCalendar {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return BusinessCalendar{
+		hours:    hours,
+		loc:      loc,
+		holidays: holidays.normalized(),
+	}
+}
+
+// WorkingTime working time intervals within the window, sorted and merged.
+func (c *BusinessCalendar) WorkingTime(window Span) SpanMany {
+	working := c.hours.Materialize(window, c.loc)
+	return working.exceptMany(c.holidays)
+}
+
+// IsWorking checking that t is working time.
+func (c *BusinessCalendar) IsWorking(t time.Time) bool {
+	working := c.WorkingTime(Span{start: t, end: t.Add(1)})
+	return len(working.spans) > 0
+}
+
+// NextWorkingInstant the first working instant at or after t.
+// Working time is searched for about 10 years after t, if none is found (no working hours
+// or holidays covering the whole period) an error wrapping ErrNoWorkingTime is returned.
+func (c *BusinessCalendar) NextWorkingInstant(t time.Time) (time.Time, error) {
+	if c.hours.IsEmpty() {
+		return time.Time{}, fmt.Errorf("%w: business calendar has no working hours", ErrNoWorkingTime)
+	}
+	until := t.Add(businessSearchLimit)
+	for from := t; from.Before(until); from = from.Add(businessSearchStep) {
+		working := c.WorkingTime(Span{start: from, end: from.Add(businessSearchStep)})
+		if len(working.spans) > 0 {
+			return working.spans[0].start, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %v - %v", ErrNoWorkingTime, t, until)
+}
+
+// AddWorkingDuration the instant when d of working time has passed since t.
+// If the working time runs out exactly at the end of working hours, the end is returned.
+// Working time is searched for about 10 years after t, if d is not accumulated by then
+// an error wrapping ErrNoWorkingTime is returned.
+func (c *BusinessCalendar) AddWorkingDuration(t time.Time, d time.Duration) (time.Time, error) {
+	if d < 0 {
+		return time.Time{}, errors.New("working duration cannot be negative")
+	}
+	if d == 0 {
+		return t, nil
+	}
+	if c.hours.IsEmpty() {
+		return time.Time{}, fmt.Errorf("%w: business calendar has no working hours", ErrNoWorkingTime)
+	}
+	until := t.Add(businessSearchLimit)
+	for from := t; from.Before(until); from = from.Add(businessSearchStep) {
+		working := c.WorkingTime(Span{start: from, end: from.Add(businessSearchStep)})
+		for _, sp := range working.spans {
+			if d <= sp.Duration() {
+				return sp.start.Add(d), nil
+			}
+			d -= sp.Duration()
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %v - %v", ErrNoWorkingTime, t, until)
+}
+
+// WorkingDurationBetween working time between t1 and t2, negative if t2 is before t1.
+func (c *BusinessCalendar) WorkingDurationBetween(t1, t2 time.Time) time.Duration {
+	if t2.Before(t1) {
+		return -c.WorkingDurationBetween(t2, t1)
+	}
+	working := c.WorkingTime(Span{start: t1, end: t2})
+	return working.Duration()
+}
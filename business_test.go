@@ -0,0 +1,164 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBusinessCalendar(t *testing.T) BusinessCalendar {
+	hours := NewWeeklyTemplate()
+	for d := time.Monday; d <= time.Friday; d++ {
+		require.NoError(t, hours.Add(d, 9*time.Hour, 17*time.Hour))
+	}
+	holidays := NewMany(Span{
+		time.Date(2020, 10, 21, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 10, 22, 0, 0, 0, 0, time.UTC),
+	})
+	return NewBusinessCalendar(hours, time.UTC, holidays)
+}
+
+func TestBusinessCalendarIsWorking(t *testing.T) {
+	c := newTestBusinessCalendar(t)
+	testCases := []struct {
+		name     string
+		t        time.Time
+		excepted bool
+	}{
+		{name: "working", t: time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC), excepted: true},
+		{name: "start", t: time.Date(2020, 10, 19, 9, 0, 0, 0, time.UTC), excepted: true},
+		{name: "end", t: time.Date(2020, 10, 19, 17, 0, 0, 0, time.UTC), excepted: false},
+		{name: "holiday", t: time.Date(2020, 10, 21, 10, 0, 0, 0, time.UTC), excepted: false},
+		{name: "weekend", t: time.Date(2020, 10, 24, 10, 0, 0, 0, time.UTC), excepted: false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.excepted, c.IsWorking(tc.t))
+		})
+	}
+}
+
+func TestBusinessCalendarNextWorkingInstant(t *testing.T) {
+	c := newTestBusinessCalendar(t)
+	testCases := []struct {
+		name     string
+		t        time.Time
+		excepted time.Time
+	}{
+		{
+			name:     "working",
+			t:        time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC),
+			excepted: time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "over_holiday",
+			t:        time.Date(2020, 10, 20, 18, 0, 0, 0, time.UTC),
+			excepted: time.Date(2020, 10, 22, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "over_weekend",
+			t:        time.Date(2020, 10, 23, 17, 0, 0, 0, time.UTC),
+			excepted: time.Date(2020, 10, 26, 9, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := c.NextWorkingInstant(tc.t)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.excepted, result)
+		})
+	}
+}
+
+func TestBusinessCalendarAddWorkingDuration(t *testing.T) {
+	c := newTestBusinessCalendar(t)
+	testCases := []struct {
+		name     string
+		t        time.Time
+		d        time.Duration
+		excepted time.Time
+	}{
+		{
+			name:     "same_day",
+			t:        time.Date(2020, 10, 19, 15, 0, 0, 0, time.UTC),
+			d:        2 * time.Hour,
+			excepted: time.Date(2020, 10, 19, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "over_holiday",
+			t:        time.Date(2020, 10, 19, 15, 0, 0, 0, time.UTC),
+			d:        16 * time.Hour,
+			excepted: time.Date(2020, 10, 22, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "from_weekend",
+			t:        time.Date(2020, 10, 24, 12, 0, 0, 0, time.UTC),
+			d:        time.Hour,
+			excepted: time.Date(2020, 10, 26, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "many_weeks",
+			t:        time.Date(2020, 10, 26, 9, 0, 0, 0, time.UTC),
+			d:        10 * 40 * time.Hour,
+			excepted: time.Date(2021, 1, 1, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "zero",
+			t:        time.Date(2020, 10, 24, 12, 0, 0, 0, time.UTC),
+			excepted: time.Date(2020, 10, 24, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := c.AddWorkingDuration(tc.t, tc.d)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.excepted, result)
+		})
+	}
+
+	_, err := c.AddWorkingDuration(time.Date(2020, 10, 19, 15, 0, 0, 0, time.UTC), -time.Hour)
+	assert.Error(t, err)
+	empty := NewBusinessCalendar(NewWeeklyTemplate(), nil, NewMany())
+	_, err = empty.AddWorkingDuration(time.Date(2020, 10, 19, 15, 0, 0, 0, time.UTC), time.Hour)
+	assert.True(t, errors.Is(err, ErrNoWorkingTime))
+	_, err = empty.NextWorkingInstant(time.Date(2020, 10, 19, 15, 0, 0, 0, time.UTC))
+	assert.True(t, errors.Is(err, ErrNoWorkingTime))
+}
+
+func TestBusinessCalendarSearchLimit(t *testing.T) {
+	hours := NewWeeklyTemplate()
+	require.NoError(t, hours.Add(time.Monday, 9*time.Hour, 17*time.Hour))
+	from := time.Date(2020, 10, 19, 0, 0, 0, 0, time.UTC)
+	closed := NewBusinessCalendar(hours, time.UTC, NewMany(Span{from, from.AddDate(20, 0, 0)}))
+
+	result, err := closed.NextWorkingInstant(from)
+	assert.True(t, errors.Is(err, ErrNoWorkingTime))
+	assert.Equal(t, time.Time{}, result)
+
+	result, err = closed.AddWorkingDuration(from, time.Hour)
+	assert.True(t, errors.Is(err, ErrNoWorkingTime))
+	assert.Equal(t, time.Time{}, result)
+
+	// 8 hours a week, 20 years of working time is not accumulated within the limit
+	open := NewBusinessCalendar(hours, time.UTC, NewMany())
+	_, err = open.AddWorkingDuration(from, 20*52*8*time.Hour)
+	assert.True(t, errors.Is(err, ErrNoWorkingTime))
+	result, err = open.AddWorkingDuration(from, 52*8*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 10, 11, 17, 0, 0, 0, time.UTC), result)
+}
+
+func TestBusinessCalendarWorkingDurationBetween(t *testing.T) {
+	c := newTestBusinessCalendar(t)
+	t1 := time.Date(2020, 10, 19, 15, 0, 0, 0, time.UTC)
+	t2 := time.Date(2020, 10, 22, 15, 0, 0, 0, time.UTC)
+	assert.Equal(t, 16*time.Hour, c.WorkingDurationBetween(t1, t2))
+	assert.Equal(t, -16*time.Hour, c.WorkingDurationBetween(t2, t1))
+	assert.Equal(t, time.Duration(0), c.WorkingDurationBetween(t1, t1))
+}
@@ -0,0 +1,171 @@
+package timeinterval
+
+import "fmt"
+
+// ConflictPolicy behavior of Calendar when a booking intersects existing bookings.
+type ConflictPolicy int
+
+const (
+	// Reject rejects the booking if it intersects any existing booking (default).
+	Reject ConflictPolicy = iota
+	// AllowUpToCapacity accepts the booking while the number of overlapping bookings does not exceed the capacity.
+	AllowUpToCapacity
+	// SplitAroundConflicts books only the free parts of the requested time interval.
+	SplitAroundConflicts
+	// OverrideByPriority cuts the requested time interval out of existing bookings with lower priority.
+	OverrideByPriority
+)
+
+// Booking time interval booked in Calendar.
+type Booking struct {
+	Span     Span
+	Priority int
+}
+
+// ConflictError booking was rejected because of the conflicting time intervals.
+type ConflictError struct {
+	Conflicts SpanMany
+}
+
+// Error implementation interface error for ConflictError.
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("booking conflicts with %d existing time intervals", len(e.Conflicts.spans))
+}
+
+// Calendar bookings with conflict detection.
+type Calendar struct {
+	policy   ConflictPolicy
+	capacity int
+	bookings []Booking
+}
+
+// NewCalendar initialization of a new Calendar with the conflict policy.
+
+// capacity - maximum number of overlapping bookings for AllowUpToCapacity (default 1).
+func NewCalendar(policy ConflictPolicy, capacity ...int) *Calendar {
+	c := &Calendar{
+		policy:   policy,
+		capacity: 1,
+	}
+	if len(capacity) > 0 && capacity[0] > 0 {
+		c.capacity = capacity[0]
+	}
+	return c
+}
+
+// Book booking a time interval according to the conflict policy of Calendar.
+// Returns booked time intervals, with SplitAroundConflicts there can be several of them.
+// If the booking is rejected *ConflictError is returned.
+
+// priority - priority of the booking for OverrideByPriority (default 0).
+func (c *Calendar) Book(span Span, priority ...int) (SpanMany, error) {
+	if _, err := New(span.start, span.end); err != nil {
+		return NewMany(), err
+	}
+	booking := Booking{Span: span}
+	if len(priority) > 0 {
+		booking.Priority = priority[0]
+	}
+
+	var conflicts []Booking
+	for _, b := range c.bookings {
+		if b.Span.IsIntersection(span) {
+			conflicts = append(conflicts, b)
+		}
+	}
+	if len(conflicts) == 0 {
+		c.bookings = append(c.bookings, booking)
+		return NewMany(span), nil
+	}
+
+	switch c.policy {
+	case AllowUpToCapacity:
+		return c.bookUpToCapacity(booking, conflicts)
+	case SplitAroundConflicts:
+		return c.bookSplit(booking, conflicts)
+	case OverrideByPriority:
+		return c.bookOverride(booking, conflicts)
+	default:
+		return NewMany(), conflictError(conflicts)
+	}
+}
+
+// Bookings get a copy of all bookings of Calendar.
+func (c *Calendar) Bookings() []Booking {
+	return append([]Booking{}, c.bookings...)
+}
+
+// Spans get time intervals of all bookings of Calendar.
+func (c *Calendar) Spans() SpanMany {
+	result := NewMany()
+	for _, b := range c.bookings {
+		result.AddMany(b.Span)
+	}
+	return result
+}
+
+func (c *Calendar) bookUpToCapacity(booking Booking, conflicts []Booking) (SpanMany, error) {
+	load := NewMany(booking.Span)
+	for _, b := range conflicts {
+		load.AddMany(b.Span)
+	}
+	over := load.SpansWithDepthAtLeast(c.capacity + 1)
+	if len(over.spans) == 0 {
+		c.bookings = append(c.bookings, booking)
+		return NewMany(booking.Span), nil
+	}
+	var blocking []Booking
+	for _, b := range conflicts {
+		if over.IsIntersection(b.Span) {
+			blocking = append(blocking, b)
+		}
+	}
+	return NewMany(), conflictError(blocking)
+}
+
+func (c *Calendar) bookSplit(booking Booking, conflicts []Booking) (SpanMany, error) {
+	free := NewMany(booking.Span)
+	for _, b := range conflicts {
+		free = free.Except(b.Span)
+	}
+	if len(free.spans) == 0 {
+		return NewMany(), conflictError(conflicts)
+	}
+	for _, sp := range free.spans {
+		c.bookings = append(c.bookings, Booking{Span: sp, Priority: booking.Priority})
+	}
+	return free, nil
+}
+
+func (c *Calendar) bookOverride(booking Booking, conflicts []Booking) (SpanMany, error) {
+	var blocking []Booking
+	for _, b := range conflicts {
+		if b.Priority >= booking.Priority {
+			blocking = append(blocking, b)
+		}
+	}
+	if len(blocking) > 0 {
+		return NewMany(), conflictError(blocking)
+	}
+	bookings := make([]Booking, 0, len(c.bookings)+1)
+	for _, b := range c.bookings {
+		if !b.Span.IsIntersection(booking.Span) {
+			bookings = append(bookings, b)
+			continue
+		}
+		rest := b.Span.Except(booking.Span)
+		for _, sp := range rest.spans {
+			bookings = append(bookings, Booking{Span: sp, Priority: b.Priority})
+		}
+	}
+	c.bookings = append(bookings, booking)
+	return NewMany(booking.Span), nil
+}
+
+func conflictError(conflicts []Booking) *ConflictError {
+	spans := make([]Span, 0, len(conflicts))
+	for _, b := range conflicts {
+		spans = append(spans, b.Span)
+	}
+	return &ConflictError{Conflicts: NewMany(spans...)}
+}
@@ -0,0 +1,137 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func hours(from, to int) Span {
+	return Span{
+		time.Date(2020, 10, 19, from, 0, 0, 0, time.UTC),
+		time.Date(2020, 10, 19, to, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCalendarBook(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   ConflictPolicy
+		capacity []int
+		existing []Booking
+		request  Span
+		priority int
+
+		excepted          SpanMany
+		exceptedConflicts SpanMany
+		exceptedBookings  []Booking
+	}{
+		{
+			name:             "no_conflict",
+			policy:           Reject,
+			existing:         []Booking{{Span: hours(9, 10)}},
+			request:          hours(10, 11),
+			excepted:         NewMany(hours(10, 11)),
+			exceptedBookings: []Booking{{Span: hours(9, 10)}, {Span: hours(10, 11)}},
+		},
+		{
+			name:              "reject",
+			policy:            Reject,
+			existing:          []Booking{{Span: hours(9, 11)}, {Span: hours(12, 13)}},
+			request:           hours(10, 12),
+			excepted:          NewMany(),
+			exceptedConflicts: NewMany(hours(9, 11)),
+			exceptedBookings:  []Booking{{Span: hours(9, 11)}, {Span: hours(12, 13)}},
+		},
+		{
+			name:             "capacity_ok",
+			policy:           AllowUpToCapacity,
+			capacity:         []int{2},
+			existing:         []Booking{{Span: hours(9, 11)}, {Span: hours(11, 13)}},
+			request:          hours(10, 12),
+			excepted:         NewMany(hours(10, 12)),
+			exceptedBookings: []Booking{{Span: hours(9, 11)}, {Span: hours(11, 13)}, {Span: hours(10, 12)}},
+		},
+		{
+			name:              "capacity_exceeded",
+			policy:            AllowUpToCapacity,
+			capacity:          []int{2},
+			existing:          []Booking{{Span: hours(9, 11)}, {Span: hours(10, 13)}, {Span: hours(12, 14)}},
+			request:           hours(10, 11),
+			excepted:          NewMany(),
+			exceptedConflicts: NewMany(hours(9, 11), hours(10, 13)),
+			exceptedBookings:  []Booking{{Span: hours(9, 11)}, {Span: hours(10, 13)}, {Span: hours(12, 14)}},
+		},
+		{
+			name:     "split",
+			policy:   SplitAroundConflicts,
+			existing: []Booking{{Span: hours(10, 11)}, {Span: hours(12, 13)}},
+			request:  hours(9, 14),
+			priority: 1,
+			excepted: NewMany(hours(9, 10), hours(11, 12), hours(13, 14)),
+			exceptedBookings: []Booking{
+				{Span: hours(10, 11)}, {Span: hours(12, 13)},
+				{Span: hours(9, 10), Priority: 1}, {Span: hours(11, 12), Priority: 1}, {Span: hours(13, 14), Priority: 1},
+			},
+		},
+		{
+			name:              "split_nothing_left",
+			policy:            SplitAroundConflicts,
+			existing:          []Booking{{Span: hours(9, 14)}},
+			request:           hours(10, 11),
+			excepted:          NewMany(),
+			exceptedConflicts: NewMany(hours(9, 14)),
+			exceptedBookings:  []Booking{{Span: hours(9, 14)}},
+		},
+		{
+			name:     "override",
+			policy:   OverrideByPriority,
+			existing: []Booking{{Span: hours(9, 14), Priority: 1}, {Span: hours(15, 16), Priority: 5}},
+			request:  hours(10, 11),
+			priority: 2,
+			excepted: NewMany(hours(10, 11)),
+			exceptedBookings: []Booking{
+				{Span: hours(9, 10), Priority: 1}, {Span: hours(11, 14), Priority: 1},
+				{Span: hours(15, 16), Priority: 5}, {Span: hours(10, 11), Priority: 2},
+			},
+		},
+		{
+			name:              "override_rejected",
+			policy:            OverrideByPriority,
+			existing:          []Booking{{Span: hours(9, 11), Priority: 1}, {Span: hours(11, 12), Priority: 3}},
+			request:           hours(10, 12),
+			priority:          2,
+			excepted:          NewMany(),
+			exceptedConflicts: NewMany(hours(11, 12)),
+			exceptedBookings:  []Booking{{Span: hours(9, 11), Priority: 1}, {Span: hours(11, 12), Priority: 3}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewCalendar(tc.policy, tc.capacity...)
+			c.bookings = append(c.bookings, tc.existing...)
+			result, err := c.Book(tc.request, tc.priority)
+			assert.Equal(t, tc.excepted, result)
+			assert.Equal(t, tc.exceptedBookings, c.Bookings())
+			if len(tc.exceptedConflicts.spans) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var conflictErr *ConflictError
+			assert.True(t, errors.As(err, &conflictErr))
+			assert.Equal(t, tc.exceptedConflicts, conflictErr.Conflicts)
+		})
+	}
+}
+
+func TestCalendarBookInvalid(t *testing.T) {
+	c := NewCalendar(Reject)
+	_, err := c.Book(Span{})
+	assert.Error(t, err)
+	assert.Empty(t, c.Bookings())
+	spans := c.Spans()
+	assert.Equal(t, NewMany(), spans)
+}
@@ -0,0 +1,93 @@
+package timeinterval
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+)
+
+// Date civil date without time of day and time zone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate initialization of a new date, values out of range are normalized as in time.Date
+// (October 32 is November 1).
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf date of t in the location of t.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{
+		Year:  y,
+		Month: m,
+		Day:   d,
+	}
+}
+
+// isNormalized the date exists in the calendar, as returned by NewDate.
+func (d Date) isNormalized() bool {
+	return d == NewDate(d.Year, d.Month, d.Day)
+}
+
+// String implementation interface stringer for Date in format 2006-01-02.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsZero the date is not set.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// In midnight of the date in the location loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// Weekday day of the week of the date.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// AddDays date n days later, n may be negative.
+func (d Date) AddDays(n int) Date {
+	return NewDate(d.Year, d.Month, d.Day+n)
+}
+
+// DaysUntil number of days from the date to e, negative if e is before the date.
+func (d Date) DaysUntil(e Date) int {
+	return int((e.In(time.UTC).Unix() - d.In(time.UTC).Unix()) / int64(dayLength/time.Second))
+}
+
+// Compare comparison of dates: -1 if the date is before e, 0 if equal, +1 if after.
+func (d Date) Compare(e Date) int {
+	switch {
+	case d.Year != e.Year:
+		return cmp.Compare(d.Year, e.Year)
+	case d.Month != e.Month:
+		return cmp.Compare(int(d.Month), int(e.Month))
+	}
+	return cmp.Compare(d.Day, e.Day)
+}
+
+// Before the date is before e.
+func (d Date) Before(e Date) bool {
+	return d.Compare(e) < 0
+}
+
+// After the date is after e.
+func (d Date) After(e Date) bool {
+	return d.Compare(e) > 0
+}
+
+// dateOrder ordering of dates, the domain of DateRange.
+type dateOrder struct{}
+
+func (dateOrder) Compare(a, b Date) int {
+	return a.Compare(b)
+}
@@ -0,0 +1,72 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate(t *testing.T) {
+	testCases := []struct {
+		name string
+		d    Date
+		days int
+
+		excepted        Date
+		exceptedWeekday time.Weekday
+	}{
+		{
+			name:            "next_day",
+			d:               NewDate(2024, time.February, 28),
+			days:            1,
+			excepted:        Date{Year: 2024, Month: time.February, Day: 29},
+			exceptedWeekday: time.Thursday,
+		},
+		{
+			name:            "next_month",
+			d:               NewDate(2023, time.February, 28),
+			days:            1,
+			excepted:        Date{Year: 2023, Month: time.March, Day: 1},
+			exceptedWeekday: time.Wednesday,
+		},
+		{
+			name:            "previous_year",
+			d:               NewDate(2024, time.January, 1),
+			days:            -1,
+			excepted:        Date{Year: 2023, Month: time.December, Day: 31},
+			exceptedWeekday: time.Sunday,
+		},
+		{
+			name:            "normalized",
+			d:               NewDate(2024, time.October, 32),
+			days:            365,
+			excepted:        Date{Year: 2025, Month: time.November, Day: 1},
+			exceptedWeekday: time.Saturday,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.d.AddDays(tc.days)
+			assert.Equal(t, tc.excepted, result)
+			assert.Equal(t, tc.days, tc.d.DaysUntil(result))
+			assert.Equal(t, -tc.days, result.DaysUntil(tc.d))
+			assert.Equal(t, tc.exceptedWeekday, result.Weekday())
+		})
+	}
+}
+
+func TestDateOf(t *testing.T) {
+	tokyo := time.FixedZone("UTC+9", 9*60*60)
+	utc := time.Date(2024, time.March, 31, 20, 0, 0, 0, time.UTC)
+	assert.Equal(t, NewDate(2024, time.March, 31), DateOf(utc))
+	assert.Equal(t, NewDate(2024, time.April, 1), DateOf(utc.In(tokyo)))
+	assert.Equal(t, time.Date(2024, time.April, 1, 0, 0, 0, 0, tokyo), NewDate(2024, time.April, 1).In(tokyo))
+	assert.Equal(t, "2024-04-01", NewDate(2024, time.April, 1).String())
+
+	assert.True(t, NewDate(2024, time.April, 1).After(NewDate(2024, time.March, 31)))
+	assert.True(t, NewDate(2023, time.December, 31).Before(NewDate(2024, time.January, 1)))
+	assert.Equal(t, 0, NewDate(2024, time.April, 1).Compare(NewDate(2024, time.March, 32)))
+	assert.True(t, Date{}.IsZero())
+}
@@ -0,0 +1,288 @@
+package timeinterval
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DateRange range of civil dates from First to Last, both inclusive.
+// A range of one date has First equal to Last.
+type DateRange struct {
+	first Date
+	last  Date
+}
+
+// dateInterval half-open interval of dates [first, last+1), the generic form of DateRange.
+type dateInterval = Interval[Date, dateOrder]
+
+// NewDateRange initialization of a new date range, first and last are inclusive.
+// Returns *IntervalError wrapping ErrZeroTime if a date is not set, ErrInvalidDate if a date does not exist
+// (February 30) or ErrInverted if last is before first.
+func NewDateRange(first, last Date) (DateRange, error) {
+	var reason error
+	switch {
+	case first.IsZero() || last.IsZero():
+		reason = ErrZeroTime
+	case !first.isNormalized() || !last.isNormalized():
+		reason = ErrInvalidDate
+	}
+	if reason != nil {
+		return DateRange{}, &IntervalError[Date]{
+			Start: first,
+			End:   last,
+			Err:   reason,
+		}
+	}
+	// first equal to last is a range of one date, not an empty one
+	if _, err := NewInterval[Date, dateOrder](first, last); errors.Is(err, ErrInverted) {
+		return DateRange{}, err
+	}
+	return DateRange{
+		first: first,
+		last:  last,
+	}, nil
+}
+
+// First returning first date of the range.
+func (r *DateRange) First() Date {
+	return r.first
+}
+
+// Last returning last date of the range.
+func (r *DateRange) Last() Date {
+	return r.last
+}
+
+// String implementation interface stringer for DateRange.
+func (r *DateRange) String() string {
+	return fmt.Sprintf("%v - %v", r.first, r.last)
+}
+
+// IsEmpty defines empty range.
+func (r *DateRange) IsEmpty() bool {
+	return r.first.IsZero() && r.last.IsZero()
+}
+
+// Days number of dates in the range, both ends included.
+func (r *DateRange) Days() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.first.DaysUntil(r.last) + 1
+}
+
+// Nights number of nights between the first and the last date (a stay from Monday to Wednesday has 2 nights).
+func (r *DateRange) Nights() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.first.DaysUntil(r.last)
+}
+
+// Dates every date of the range in ascending order.
+func (r *DateRange) Dates() []Date {
+	dates := make([]Date, 0, r.Days())
+	for d := r.first; !r.IsEmpty() && !d.After(r.last); d = d.AddDays(1) {
+		dates = append(dates, d)
+	}
+	return dates
+}
+
+// Contains the date is in the range.
+func (r *DateRange) Contains(d Date) bool {
+	return !r.IsEmpty() && !d.Before(r.first) && !d.After(r.last)
+}
+
+// Span time interval from midnight of the first date to midnight after the last date in the location loc,
+// nil means UTC.
+func (r *DateRange) Span(loc *time.Location) Span {
+	if r.IsEmpty() {
+		return Span{}
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return Span{
+		start: r.first.In(loc),
+		end:   r.last.AddDays(1).In(loc),
+	}
+}
+
+// Equal full equals of two date ranges.
+func (r *DateRange) Equal(input DateRange) bool {
+	return r.first == input.first && r.last == input.last
+}
+
+// IsIntersection check for at least one common date.
+func (r *DateRange) IsIntersection(input DateRange) bool {
+	in := r.interval()
+	return in.IsIntersection(input.interval())
+}
+
+// IsContains check every date of input is in the range.
+func (r *DateRange) IsContains(input DateRange) bool {
+	in := r.interval()
+	return in.IsContains(input.interval())
+}
+
+// Intersection common dates of two date ranges, empty range if there are none.
+func (r *DateRange) Intersection(input DateRange) DateRange {
+	in := r.interval()
+	return dateRangeOf(in.Intersection(input.interval()))
+}
+
+// Union union of two date ranges, overlapping or consecutive ranges are merged.
+func (r *DateRange) Union(input DateRange) DateRangeSet {
+	in := r.interval()
+	return dateRangeSetOf(in.Union(input.interval()))
+}
+
+// Except dates of the range not in input (r \ input).
+func (r *DateRange) Except(input DateRange) DateRangeSet {
+	in := r.interval()
+	return dateRangeSetOf(in.Except(input.interval()))
+}
+
+// interval the range as the generic half-open interval [first, last+1).
+func (r *DateRange) interval() dateInterval {
+	if r.IsEmpty() {
+		return dateInterval{}
+	}
+	return dateInterval{
+		start: r.first,
+		end:   r.last.AddDays(1),
+	}
+}
+
+// dateRangeOf date range of the half-open interval [start, end).
+func dateRangeOf(i dateInterval) DateRange {
+	if i.IsEmpty() {
+		return DateRange{}
+	}
+	return DateRange{
+		first: i.start,
+		last:  i.end.AddDays(-1),
+	}
+}
+
+// DateRangeSet model containing more than one date range.
+type DateRangeSet struct {
+	ranges []DateRange
+}
+
+// NewDateRangeSet initialization for multiple date ranges.
+func NewDateRangeSet(ranges ...DateRange) DateRangeSet {
+	if len(ranges) == 0 {
+		return DateRangeSet{
+			ranges: []DateRange{},
+		}
+	}
+	return DateRangeSet{
+		ranges: ranges,
+	}
+}
+
+// AddMany adding several date ranges at once to the existing one DateRangeSet.
+func (s *DateRangeSet) AddMany(ranges ...DateRange) {
+	if s == nil || len(ranges) == 0 {
+		return
+	}
+	s.ranges = append(s.ranges, ranges...)
+}
+
+// String implementation interface stringer for DateRangeSet.
+func (s *DateRangeSet) String() string {
+	str := "["
+	for _, r := range s.ranges {
+		str += fmt.Sprintf("\n\t%v - %v", r.first, r.last)
+	}
+	str += "\n]"
+	return str
+}
+
+// Ranges get an array of date ranges.
+func (s *DateRangeSet) Ranges() []DateRange {
+	if s.ranges == nil {
+		return []DateRange{}
+	}
+	return s.ranges
+}
+
+// Days total number of dates of all ranges.
+// Overlapping ranges are counted as many times as they overlap, call Union first to avoid it.
+func (s *DateRangeSet) Days() int {
+	var days int
+	for _, r := range s.ranges {
+		days += r.Days()
+	}
+	return days
+}
+
+// Span time intervals of all date ranges in the location loc, nil means UTC.
+func (s *DateRangeSet) Span(loc *time.Location) SpanMany {
+	spans := make([]Span, 0, len(s.ranges))
+	for _, r := range s.ranges {
+		spans = append(spans, r.Span(loc))
+	}
+	return NewMany(spans...)
+}
+
+// IsIntersection checking for intersection of a date range with one of DateRangeSet.
+// If there is at least one match, return true.
+func (s *DateRangeSet) IsIntersection(input DateRange) bool {
+	set := s.intervals()
+	return set.IsIntersection(input.interval())
+}
+
+// IsContains checking for contains of a date range in one of DateRangeSet.
+// If there is at least one match, return true.
+func (s *DateRangeSet) IsContains(input DateRange) bool {
+	set := s.intervals()
+	return set.IsContains(input.interval())
+}
+
+// Intersection intersecting every date range of DateRangeSet with input.
+func (s *DateRangeSet) Intersection(input DateRange) DateRangeSet {
+	set := s.intervals()
+	return dateRangeSetOf(set.Intersection(input.interval()))
+}
+
+// Except difference between each date range of DateRangeSet and input (s[i] \ input).
+// The result is sorted and merged.
+func (s *DateRangeSet) Except(input DateRange) DateRangeSet {
+	set := s.intervals()
+	return dateRangeSetOf(set.Except(input.interval()))
+}
+
+// Union concatenation DateRangeSet of array DateRangeSet.
+// The result is sorted, overlapping or consecutive ranges are merged, the receiver is not modified.
+func (s *DateRangeSet) Union(input ...DateRangeSet) DateRangeSet {
+	set := s.intervals()
+	others := make([]IntervalSet[Date, dateOrder], 0, len(input))
+	for _, inp := range input {
+		others = append(others, inp.intervals())
+	}
+	return dateRangeSetOf(set.Union(others...))
+}
+
+// intervals date ranges as the generic set of half-open intervals.
+func (s *DateRangeSet) intervals() IntervalSet[Date, dateOrder] {
+	result := make([]dateInterval, 0, len(s.ranges))
+	for _, r := range s.ranges {
+		result = append(result, r.interval())
+	}
+	return NewIntervalSet(result...)
+}
+
+// dateRangeSetOf date ranges of the generic set of half-open intervals, empty intervals are dropped.
+func dateRangeSetOf(set IntervalSet[Date, dateOrder]) DateRangeSet {
+	result := make([]DateRange, 0, len(set.intervals))
+	for _, i := range set.intervals {
+		if i.IsEmpty() {
+			continue
+		}
+		result = append(result, dateRangeOf(i))
+	}
+	return NewDateRangeSet(result...)
+}
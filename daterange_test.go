@@ -0,0 +1,167 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// days date range of March 2024 from the day first to the day last.
+func days(first, last int) DateRange {
+	return DateRange{first: NewDate(2024, time.March, first), last: NewDate(2024, time.March, last)}
+}
+
+func TestNewDateRange(t *testing.T) {
+	r, err := NewDateRange(NewDate(2024, time.March, 1), NewDate(2024, time.March, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, days(1, 1), r)
+	assert.Equal(t, 1, r.Days())
+	assert.Equal(t, 0, r.Nights())
+
+	testCases := []struct {
+		name        string
+		first, last Date
+		err         error
+	}{
+		{name: "inverted", first: NewDate(2024, time.March, 2), last: NewDate(2024, time.March, 1), err: ErrInverted},
+		{name: "zero", first: Date{}, last: NewDate(2024, time.March, 1), err: ErrZeroTime},
+		{name: "february_30", first: Date{Year: 2024, Month: time.February, Day: 30}, last: NewDate(2024, time.March, 1), err: ErrInvalidDate},
+		{name: "month_13", first: NewDate(2024, time.March, 1), last: Date{Year: 2024, Month: 13, Day: 1}, err: ErrInvalidDate},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := NewDateRange(tc.first, tc.last)
+			assert.True(t, errors.Is(err, tc.err))
+			assert.Equal(t, DateRange{}, r)
+			var intervalErr *IntervalError[Date]
+			assert.True(t, errors.As(err, &intervalErr))
+			assert.Equal(t, tc.first, intervalErr.Start)
+			assert.Equal(t, tc.last, intervalErr.End)
+		})
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	testCases := []struct {
+		name  string
+		r     DateRange
+		input DateRange
+
+		exceptedIsIntersection bool
+		exceptedIntersection   DateRange
+		exceptedUnion          DateRangeSet
+		exceptedExcept         DateRangeSet
+	}{
+		{
+			name:                   "overlap",
+			r:                      days(1, 10),
+			input:                  days(10, 15),
+			exceptedIsIntersection: true,
+			exceptedIntersection:   days(10, 10),
+			exceptedUnion:          NewDateRangeSet(days(1, 15)),
+			exceptedExcept:         NewDateRangeSet(days(1, 9)),
+		},
+		{
+			name:                   "inside",
+			r:                      days(1, 10),
+			input:                  days(3, 5),
+			exceptedIsIntersection: true,
+			exceptedIntersection:   days(3, 5),
+			exceptedUnion:          NewDateRangeSet(days(1, 10)),
+			exceptedExcept:         NewDateRangeSet(days(1, 2), days(6, 10)),
+		},
+		{
+			name:           "consecutive",
+			r:              days(1, 10),
+			input:          days(11, 20),
+			exceptedUnion:  NewDateRangeSet(days(1, 20)),
+			exceptedExcept: NewDateRangeSet(days(1, 10)),
+		},
+		{
+			name:           "empty_input",
+			r:              days(1, 10),
+			input:          DateRange{},
+			exceptedUnion:  NewDateRangeSet(days(1, 10)),
+			exceptedExcept: NewDateRangeSet(days(1, 10)),
+		},
+		{
+			name:           "apart",
+			r:              days(1, 10),
+			input:          days(12, 20),
+			exceptedUnion:  NewDateRangeSet(days(1, 10), days(12, 20)),
+			exceptedExcept: NewDateRangeSet(days(1, 10)),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exceptedIsIntersection, tc.r.IsIntersection(tc.input))
+			assert.Equal(t, tc.exceptedIntersection, tc.r.Intersection(tc.input))
+			assert.Equal(t, tc.exceptedUnion, tc.r.Union(tc.input))
+			assert.Equal(t, tc.exceptedExcept, tc.r.Except(tc.input))
+		})
+	}
+}
+
+func TestDateRangeDays(t *testing.T) {
+	stay := DateRange{first: NewDate(2024, time.February, 27), last: NewDate(2024, time.March, 2)}
+	assert.Equal(t, 5, stay.Days())
+	assert.Equal(t, 4, stay.Nights())
+	assert.Equal(t, []Date{
+		NewDate(2024, time.February, 27),
+		NewDate(2024, time.February, 28),
+		NewDate(2024, time.February, 29),
+		NewDate(2024, time.March, 1),
+		NewDate(2024, time.March, 2),
+	}, stay.Dates())
+	assert.True(t, stay.Contains(NewDate(2024, time.February, 29)))
+	assert.False(t, stay.Contains(NewDate(2024, time.March, 3)))
+
+	empty := DateRange{}
+	assert.Equal(t, 0, empty.Days())
+	assert.Equal(t, []Date{}, empty.Dates())
+	assert.Equal(t, Span{}, empty.Span(time.UTC))
+}
+
+func TestDateRangeSpan(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// clocks go forward on March 10, 2024 in New York
+	r := days(9, 10)
+	span := r.Span(newYork)
+	assert.Equal(t, time.Date(2024, time.March, 9, 0, 0, 0, 0, newYork), span.Start())
+	assert.Equal(t, time.Date(2024, time.March, 11, 0, 0, 0, 0, newYork), span.End())
+	assert.Equal(t, 47*time.Hour, span.Duration())
+
+	// nil location means UTC
+	assert.Equal(t, dateSpan(2024, time.March, 9, 2024, time.March, 11), r.Span(nil))
+	set := NewDateRangeSet(r)
+	assert.Equal(t, NewMany(dateSpan(2024, time.March, 9, 2024, time.March, 11)), set.Span(nil))
+}
+
+func TestDateRangeSet(t *testing.T) {
+	set := NewDateRangeSet(days(20, 25), days(1, 5), days(6, 8), days(4, 7))
+
+	assert.Equal(t, NewDateRangeSet(days(1, 8), days(20, 25)), set.Union())
+	assert.Equal(t, NewDateRangeSet(days(1, 8), days(20, 31)), set.Union(NewDateRangeSet(days(26, 31))))
+	assert.Equal(t, NewDateRangeSet(days(1, 3), days(21, 25)), set.Except(days(4, 20)))
+	assert.Equal(t, NewDateRangeSet(days(20, 20), days(5, 5), days(6, 8), days(5, 7)), set.Intersection(days(5, 20)))
+	assert.True(t, set.IsIntersection(days(25, 26)))
+	assert.False(t, set.IsIntersection(days(9, 19)))
+	assert.True(t, set.IsContains(days(6, 7)))
+	assert.Equal(t, 6+5+3+4, set.Days())
+
+	set.AddMany(days(30, 31))
+	assert.Equal(t, NewMany(
+		Span{start: time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC), end: time.Date(2024, time.March, 26, 0, 0, 0, 0, time.UTC)},
+		Span{start: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), end: time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC)},
+		Span{start: time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC), end: time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC)},
+		Span{start: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), end: time.Date(2024, time.March, 8, 0, 0, 0, 0, time.UTC)},
+		Span{start: time.Date(2024, time.March, 30, 0, 0, 0, 0, time.UTC), end: time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)},
+	), set.Span(time.UTC))
+}
@@ -0,0 +1,101 @@
+package timeinterval
+
+import (
+	"sort"
+	"time"
+)
+
+// DepthSegment part of the depth profile: time interval and the number of SpanMany intervals overlapping in it.
+type DepthSegment struct {
+	Span  Span
+	Depth int
+}
+
+// depthEvent start (+1) or end (-1) of a time interval for the sweep.
+type depthEvent struct {
+	at    time.Time
+	delta int
+}
+
+// DepthProfile piecewise-constant function of the number of overlapping time intervals.
+// Segments are sorted ascending, adjacent segments always have different depth.
+// Time not covered by any interval (depth 0) is not included in the result.
+func (s *SpanMany) DepthProfile() []DepthSegment {
+	events := make([]depthEvent, 0, len(s.spans)*2)
+	for _, sp := range s.spans {
+		if !sp.start.Before(sp.end) {
+			continue
+		}
+		events = append(events, depthEvent{at: sp.start, delta: 1}, depthEvent{at: sp.end, delta: -1})
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].at.Before(events[j].at)
+	})
+
+	var result []DepthSegment
+	depth := 0
+	for i := 0; i < len(events); {
+		at := events[i].at
+		for ; i < len(events) && events[i].at.Equal(at); i++ {
+			depth += events[i].delta
+		}
+		if depth == 0 || i == len(events) {
+			continue
+		}
+		next := events[i].at
+		if n := len(result); n > 0 && result[n-1].Depth == depth && result[n-1].Span.end.Equal(at) {
+			result[n-1].Span.end = next
+			continue
+		}
+		result = append(result, DepthSegment{
+			Span:  Span{start: at, end: next},
+			Depth: depth,
+		})
+	}
+	return result
+}
+
+// MaxDepth maximum number of time intervals overlapping at the same moment.
+func (s *SpanMany) MaxDepth() int {
+	max := 0
+	for _, seg := range s.DepthProfile() {
+		if seg.Depth > max {
+			max = seg.Depth
+		}
+	}
+	return max
+}
+
+// SpansWithDepthAtLeast time intervals where at least k intervals of SpanMany overlap.
+// The result is sorted and merged, k less than 1 is treated as 1.
+func (s *SpanMany) SpansWithDepthAtLeast(k int) SpanMany {
+	if k < 1 {
+		k = 1
+	}
+	var result []Span
+	for _, seg := range s.DepthProfile() {
+		if seg.Depth < k {
+			continue
+		}
+		if n := len(result); n > 0 && result[n-1].end.Equal(seg.Span.start) {
+			result[n-1].end = seg.Span.end
+			continue
+		}
+		result = append(result, seg.Span)
+	}
+	return NewMany(result...)
+}
+
+// TimeAtDepth total time during which exactly k intervals of SpanMany overlap.
+func (s *SpanMany) TimeAtDepth(k int) time.Duration {
+	var d time.Duration
+	if k < 1 {
+		return d
+	}
+	for _, seg := range s.DepthProfile() {
+		if seg.Depth == k {
+			d += seg.Span.Duration()
+		}
+	}
+	return d
+}
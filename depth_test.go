@@ -0,0 +1,117 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDepthProfile(t *testing.T) {
+	testCases := []struct {
+		name        string
+		newSpanMany SpanMany
+
+		excepted []DepthSegment
+	}{
+		{
+			name:        "empty",
+			newSpanMany: NewMany(),
+			excepted:    nil,
+		},
+		{
+			name: "overlapping",
+			newSpanMany: NewMany(
+				Span{
+					time.Date(2020, 10, 12, 9, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 12, 0, 0, 0, time.UTC)},
+				Span{
+					time.Date(2020, 10, 12, 10, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 11, 0, 0, 0, time.UTC)},
+				Span{
+					time.Date(2020, 10, 12, 10, 30, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 13, 0, 0, 0, time.UTC)},
+			),
+			excepted: []DepthSegment{
+				{Span{
+					time.Date(2020, 10, 12, 9, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 10, 0, 0, 0, time.UTC)}, 1},
+				{Span{
+					time.Date(2020, 10, 12, 10, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 10, 30, 0, 0, time.UTC)}, 2},
+				{Span{
+					time.Date(2020, 10, 12, 10, 30, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 11, 0, 0, 0, time.UTC)}, 3},
+				{Span{
+					time.Date(2020, 10, 12, 11, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 12, 0, 0, 0, time.UTC)}, 2},
+				{Span{
+					time.Date(2020, 10, 12, 12, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 13, 0, 0, 0, time.UTC)}, 1},
+			},
+		},
+		{
+			name: "touching_and_gap",
+			newSpanMany: NewMany(
+				Span{
+					time.Date(2020, 10, 12, 14, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 15, 0, 0, 0, time.UTC)},
+				Span{
+					time.Date(2020, 10, 12, 9, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 10, 0, 0, 0, time.UTC)},
+				Span{
+					time.Date(2020, 10, 12, 10, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 11, 0, 0, 0, time.UTC)},
+			),
+			excepted: []DepthSegment{
+				{Span{
+					time.Date(2020, 10, 12, 9, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 11, 0, 0, 0, time.UTC)}, 1},
+				{Span{
+					time.Date(2020, 10, 12, 14, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 12, 15, 0, 0, 0, time.UTC)}, 1},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.newSpanMany.DepthProfile()
+			assert.Equal(t, tc.excepted, result)
+		})
+	}
+}
+
+func TestDepthHelpers(t *testing.T) {
+	bookings := NewMany(
+		Span{
+			time.Date(2020, 10, 12, 9, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 12, 12, 0, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 10, 12, 10, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 12, 11, 0, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 10, 12, 10, 30, 0, 0, time.UTC),
+			time.Date(2020, 10, 12, 13, 0, 0, 0, time.UTC)},
+	)
+	assert.Equal(t, 3, bookings.MaxDepth())
+	empty := NewMany()
+	assert.Equal(t, 0, empty.MaxDepth())
+
+	assert.Equal(t, NewMany(
+		Span{
+			time.Date(2020, 10, 12, 10, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 12, 12, 0, 0, 0, time.UTC)},
+	), bookings.SpansWithDepthAtLeast(2))
+	assert.Equal(t, NewMany(
+		Span{
+			time.Date(2020, 10, 12, 9, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 12, 13, 0, 0, 0, time.UTC)},
+	), bookings.SpansWithDepthAtLeast(0))
+	assert.Equal(t, NewMany(), bookings.SpansWithDepthAtLeast(4))
+
+	assert.Equal(t, 2*time.Hour, bookings.TimeAtDepth(1))
+	assert.Equal(t, 90*time.Minute, bookings.TimeAtDepth(2))
+	assert.Equal(t, 30*time.Minute, bookings.TimeAtDepth(3))
+	assert.Equal(t, time.Duration(0), bookings.TimeAtDepth(0))
+}
@@ -0,0 +1,154 @@
+package timeinterval
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInverted time start is after time end.
+	ErrInverted = errors.New("time start cannot be more time end")
+	// ErrEmpty time start is equal time end.
+	ErrEmpty = errors.New("time start cannot be equal time end")
+	// ErrZeroTime time start or time end is not set.
+	ErrZeroTime = errors.New("time start and time end cannot be zero")
+	// ErrNilSpanMany method is called on a nil SpanMany.
+	ErrNilSpanMany = errors.New("SpanMany is nil")
+	// ErrNonexistentTime wall clock time is skipped by a time zone transition.
+	ErrNonexistentTime = errors.New("wall clock time does not exist in the location")
+	// ErrAmbiguousTime wall clock time occurs twice because of a time zone transition.
+	ErrAmbiguousTime = errors.New("wall clock time is ambiguous in the location")
+	// ErrClockOutOfRange wall clock time is not within a day.
+	ErrClockOutOfRange = errors.New("wall clock time must be within a day")
+	// ErrInvalidWeekday day of the week is not from Sunday to Saturday.
+	ErrInvalidWeekday = errors.New("invalid day of the week")
+	// ErrInvalidDate date does not exist in the calendar (February 30).
+	ErrInvalidDate = errors.New("date does not exist")
+	// ErrInvalidMonth month is not from January to December.
+	ErrInvalidMonth = errors.New("invalid month")
+	// ErrNoWorkingTime business calendar has no working time within the search limit.
+	ErrNoWorkingTime = errors.New("no working time within the search limit")
+	// ErrUnknownToken reservation with the token does not exist or is already released.
+	ErrUnknownToken = errors.New("unknown reservation token")
+	// ErrInvalidFiscalPattern fiscal quarter pattern does not consist of 13 weeks.
+	ErrInvalidFiscalPattern = errors.New("fiscal pattern must consist of 13 weeks")
+)
+
+// ValidationError invalid time interval with the reason in Err (ErrInverted, ErrEmpty or ErrZeroTime).
+type ValidationError struct {
+	Start time.Time
+	End   time.Time
+	// Index position of the time interval in the batch, -1 for a single time interval.
+	Index int
+	Err   error
+}
+
+// Error implementation interface error for ValidationError.
+func (e *ValidationError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("invalid time interval %v - %v: %v", e.Start, e.End, e.Err)
+	}
+	return fmt.Sprintf("invalid time interval %d %v - %v: %v", e.Index, e.Start, e.End, e.Err)
+}
+
+// Unwrap returning the reason of ValidationError.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// IntervalError invalid interval of the domain T with the reason in Err (ErrInverted or ErrEmpty,
+// for DateRange ErrInverted, ErrZeroTime or ErrInvalidDate).
+type IntervalError[T any] struct {
+	Start T
+	End   T
+	Err   error
+}
+
+// Error implementation interface error for IntervalError.
+func (e *IntervalError[T]) Error() string {
+	return fmt.Sprintf("invalid interval %v - %v: %v", e.Start, e.End, e.Err)
+}
+
+// Unwrap returning the reason of IntervalError.
+func (e *IntervalError[T]) Unwrap() error {
+	return e.Err
+}
+
+// ClockRangeError invalid wall clock time range with the reason in Err
+// (ErrClockOutOfRange, ErrEmpty or ErrInvalidWeekday).
+type ClockRangeError struct {
+	// Weekday day of the week of the range, -1 if the range is not bound to a day of the week.
+	Weekday time.Weekday
+	// Start, End wall clock times of the range (time elapsed since midnight).
+	Start time.Duration
+	End   time.Duration
+	Err   error
+}
+
+// Error implementation interface error for ClockRangeError.
+func (e *ClockRangeError) Error() string {
+	if e.Weekday < 0 {
+		return fmt.Sprintf("invalid wall clock range %v - %v: %v", e.Start, e.End, e.Err)
+	}
+	return fmt.Sprintf("invalid wall clock range on day %d %v - %v: %v", e.Weekday, e.Start, e.End, e.Err)
+}
+
+// Unwrap returning the reason of ClockRangeError.
+func (e *ClockRangeError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors all invalid time intervals of a batch.
+type ValidationErrors []*ValidationError
+
+// Error implementation interface error for ValidationErrors.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Is support of errors.Is: true if any of the errors matches the target.
+func (e ValidationErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As support of errors.As: the first error matching the target is set.
+func (e ValidationErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// validate checking time interval boundaries, index - position of the time interval in the batch.
+func validate(start, end time.Time, index int) error {
+	var reason error
+	switch {
+	case start.IsZero() || end.IsZero():
+		reason = ErrZeroTime
+	case start.Equal(end):
+		reason = ErrEmpty
+	case start.After(end):
+		reason = ErrInverted
+	default:
+		return nil
+	}
+	return &ValidationError{
+		Start: start,
+		End:   end,
+		Index: index,
+		Err:   reason,
+	}
+}
@@ -0,0 +1,56 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewManyChecked(t *testing.T) {
+	valid := minuteSpan(0, 10)
+	inverted := Span{minutes(10)[0], minutes(0)[0]}
+	empty := Span{minutes(10)[0], minutes(10)[0]}
+
+	result, err := NewManyChecked(valid, minuteSpan(20, 30))
+	assert.NoError(t, err)
+	assert.Equal(t, NewMany(valid, minuteSpan(20, 30)), result)
+
+	result, err = NewManyChecked(valid, inverted, Span{}, empty)
+	assert.Equal(t, NewMany(), result)
+	assert.True(t, errors.Is(err, ErrInverted))
+	assert.True(t, errors.Is(err, ErrZeroTime))
+	assert.True(t, errors.Is(err, ErrEmpty))
+
+	var validationErrs ValidationErrors
+	assert.True(t, errors.As(err, &validationErrs))
+	assert.Len(t, validationErrs, 3)
+	assert.Equal(t, []int{1, 2, 3}, []int{validationErrs[0].Index, validationErrs[1].Index, validationErrs[2].Index})
+	assert.Equal(t, inverted.start, validationErrs[0].Start)
+	assert.Equal(t, inverted.end, validationErrs[0].End)
+
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, 1, validationErr.Index)
+	assert.Contains(t, err.Error(), ErrEmpty.Error())
+
+	_, err = NewManyChecked(valid)
+	assert.False(t, errors.Is(err, ErrInverted))
+}
+
+func TestAddZeroValue(t *testing.T) {
+	var s SpanMany
+	assert.NoError(t, s.Add(minutes(0)[0], minutes(10)[0]))
+	s.AddMany(minuteSpan(20, 30))
+	assert.Equal(t, []Span{minuteSpan(0, 10), minuteSpan(20, 30)}, s.Spans())
+
+	err := s.Add(minutes(10)[0], minutes(0)[0])
+	assert.True(t, errors.Is(err, ErrInverted))
+	err = s.Add(time.Time{}, minutes(0)[0])
+	assert.True(t, errors.Is(err, ErrZeroTime))
+
+	var nilSpanMany *SpanMany
+	assert.Equal(t, ErrNilSpanMany, nilSpanMany.Add(minutes(0)[0], minutes(10)[0]))
+	nilSpanMany.AddMany(minuteSpan(20, 30))
+}
@@ -0,0 +1,207 @@
+package timeinterval
+
+import (
+	"fmt"
+	"time"
+)
+
+// FiscalPattern weeks in each of the three periods of a fiscal quarter.
+type FiscalPattern [3]int
+
+var (
+	// Pattern445 quarters of 4, 4 and 5 weeks.
+	Pattern445 = FiscalPattern{4, 4, 5}
+	// Pattern454 quarters of 4, 5 and 4 weeks.
+	Pattern454 = FiscalPattern{4, 5, 4}
+	// Pattern544 quarters of 5, 4 and 4 weeks.
+	Pattern544 = FiscalPattern{5, 4, 4}
+)
+
+// YearEndRule choice of the last day of a 52-53 week fiscal year.
+type YearEndRule int
+
+const (
+	// LastWeekdayOfMonth the year ends on the last given weekday of the month (default).
+	LastWeekdayOfMonth YearEndRule = iota
+	// NearestWeekdayToMonthEnd the year ends on the given weekday nearest to the last day of the month,
+	// it can be up to 3 days into the next month.
+	NearestWeekdayToMonthEnd
+)
+
+// FiscalCalendar retail 52-53 week fiscal calendar (4-4-5, 4-5-4 or 5-4-4).
+// A fiscal year consists of 4 quarters of 3 periods of whole weeks, all boundaries are at midnight of the location.
+// Fiscal year Y ends on the weekday chosen by the rule in the month of calendar year Y,
+// in a 53 week year the extra week is added to the last period.
+type FiscalCalendar struct {
+	pattern    FiscalPattern
+	endMonth   time.Month
+	endWeekday time.Weekday
+	rule       YearEndRule
+	loc        *time.Location
+}
+
+// FiscalDate position of an instant in the fiscal calendar, all numbers start with 1.
+type FiscalDate struct {
+	Year    int
+	Quarter int
+	// Period period of the year from 1 to 12.
+	Period int
+	// Week week of the year from 1 to 53.
+	Week int
+}
+
+// NewFiscalCalendar initialization of a new fiscal calendar with years ending on endWeekday
+// at the end of endMonth according to the rule, nil location means UTC.
+// Returns ErrInvalidFiscalPattern if the pattern does not consist of 13 weeks.
+func NewFiscalCalendar(pattern FiscalPattern, endMonth time.Month, endWeekday time.Weekday, rule YearEndRule, loc *time.Location) (*FiscalCalendar, error) {
+	weeks := 0
+	for _, w := range pattern {
+		if w <= 0 {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidFiscalPattern, pattern)
+		}
+		weeks += w
+	}
+	if weeks != 13 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFiscalPattern, pattern)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &FiscalCalendar{
+		pattern:    pattern,
+		endMonth:   endMonth,
+		endWeekday: endWeekday,
+		rule:       rule,
+		loc:        loc,
+	}, nil
+}
+
+// Year time interval of the fiscal year.
+func (c *FiscalCalendar) Year(year int) Span {
+	return c.span(c.yearEnd(year-1).AddDays(1), c.yearEnd(year).AddDays(1))
+}
+
+// WeeksIn number of weeks in the fiscal year, 52 or 53.
+func (c *FiscalCalendar) WeeksIn(year int) int {
+	return c.yearEnd(year-1).DaysUntil(c.yearEnd(year)) / 7
+}
+
+// Quarters time intervals of the 4 quarters of the fiscal year.
+func (c *FiscalCalendar) Quarters(year int) SpanMany {
+	periods := c.Periods(year)
+	result := NewMany()
+	for q := 0; q < 4; q++ {
+		result.spans = append(result.spans, Span{
+			start: periods.spans[3*q].start,
+			end:   periods.spans[3*q+2].end,
+		})
+	}
+	return result
+}
+
+// Periods time intervals of the 12 periods of the fiscal year.
+func (c *FiscalCalendar) Periods(year int) SpanMany {
+	result := NewMany()
+	start := c.yearEnd(year - 1).AddDays(1)
+	for _, weeks := range c.periodWeeks(year) {
+		end := start.AddDays(7 * weeks)
+		result.spans = append(result.spans, c.span(start, end))
+		start = end
+	}
+	return result
+}
+
+// Weeks time intervals of the 52 or 53 weeks of the fiscal year.
+func (c *FiscalCalendar) Weeks(year int) SpanMany {
+	result := NewMany()
+	start := c.yearEnd(year - 1).AddDays(1)
+	for w := 0; w < c.WeeksIn(year); w++ {
+		result.spans = append(result.spans, c.span(start.AddDays(7*w), start.AddDays(7*w+7)))
+	}
+	return result
+}
+
+// At position of t in the fiscal calendar.
+func (c *FiscalCalendar) At(t time.Time) FiscalDate {
+	date := DateOf(t.In(c.loc))
+	year := date.Year
+	if !date.After(c.yearEnd(year - 1)) {
+		year--
+	} else if date.After(c.yearEnd(year)) {
+		year++
+	}
+	week := c.yearEnd(year-1).AddDays(1).DaysUntil(date)/7 + 1
+	result := FiscalDate{
+		Year: year,
+		Week: week,
+	}
+	for i, weeks := range c.periodWeeks(year) {
+		if week <= weeks {
+			result.Period = i + 1
+			break
+		}
+		week -= weeks
+	}
+	result.Quarter = (result.Period-1)/3 + 1
+	return result
+}
+
+// PeriodAt time interval of the fiscal period containing t.
+func (c *FiscalCalendar) PeriodAt(t time.Time) Span {
+	fd := c.At(t)
+	periods := c.Periods(fd.Year)
+	return periods.spans[fd.Period-1]
+}
+
+// Split splitting the time interval by the fiscal periods,
+// every part is the intersection of the time interval with one period, in ascending order.
+func (c *FiscalCalendar) Split(s Span) SpanMany {
+	result := NewMany()
+	if !s.start.Before(s.end) {
+		return result
+	}
+	last := c.At(s.end.Add(-time.Nanosecond)).Year
+	for year := c.At(s.start).Year; year <= last; year++ {
+		periods := c.Periods(year)
+		result.AddMany(periods.Intersection(s).spans...)
+	}
+	return result
+}
+
+// SplitMany splitting every time interval of SpanMany by the fiscal periods as in Split.
+func (c *FiscalCalendar) SplitMany(s SpanMany) SpanMany {
+	result := NewMany()
+	for _, sp := range s.spans {
+		parts := c.Split(sp)
+		result.AddMany(parts.spans...)
+	}
+	return result
+}
+
+// yearEnd last day of the fiscal year.
+func (c *FiscalCalendar) yearEnd(year int) Date {
+	monthEnd := NewDate(year, c.endMonth+1, 0)
+	back := (int(monthEnd.Weekday()) - int(c.endWeekday) + 7) % 7
+	if c.rule == NearestWeekdayToMonthEnd && back > 3 {
+		return monthEnd.AddDays(7 - back)
+	}
+	return monthEnd.AddDays(-back)
+}
+
+// periodWeeks weeks in each of the 12 periods of the fiscal year.
+func (c *FiscalCalendar) periodWeeks(year int) []int {
+	weeks := make([]int, 0, 12)
+	for q := 0; q < 4; q++ {
+		weeks = append(weeks, c.pattern[:]...)
+	}
+	weeks[11] += c.WeeksIn(year) - 52
+	return weeks
+}
+
+// span time interval from midnight of the first date to midnight of the end date.
+func (c *FiscalCalendar) span(first, end Date) Span {
+	return Span{
+		start: first.In(c.loc),
+		end:   end.In(c.loc),
+	}
+}
@@ -0,0 +1,160 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newRetailCalendar 4-5-4 calendar of years ending on the Saturday nearest to the end of January.
+func newRetailCalendar(t *testing.T) *FiscalCalendar {
+	c, err := NewFiscalCalendar(Pattern454, time.January, time.Saturday, NearestWeekdayToMonthEnd, time.UTC)
+	assert.NoError(t, err)
+	return c
+}
+
+func TestNewFiscalCalendar(t *testing.T) {
+	_, err := NewFiscalCalendar(FiscalPattern{4, 4, 4}, time.December, time.Saturday, LastWeekdayOfMonth, nil)
+	assert.True(t, errors.Is(err, ErrInvalidFiscalPattern))
+	_, err = NewFiscalCalendar(FiscalPattern{0, 8, 5}, time.December, time.Saturday, LastWeekdayOfMonth, nil)
+	assert.True(t, errors.Is(err, ErrInvalidFiscalPattern))
+}
+
+func TestFiscalCalendarYear(t *testing.T) {
+	retail := newRetailCalendar(t)
+	lastSaturday, err := NewFiscalCalendar(Pattern445, time.December, time.Saturday, LastWeekdayOfMonth, nil)
+	assert.NoError(t, err)
+	testCases := []struct {
+		name     string
+		calendar *FiscalCalendar
+		year     int
+
+		excepted      Span
+		exceptedWeeks int
+	}{
+		{
+			name:          "retail_53_weeks",
+			calendar:      retail,
+			year:          2024,
+			excepted:      dateSpan(2023, time.January, 29, 2024, time.February, 4),
+			exceptedWeeks: 53,
+		},
+		{
+			name:          "retail_52_weeks",
+			calendar:      retail,
+			year:          2025,
+			excepted:      dateSpan(2024, time.February, 4, 2025, time.February, 2),
+			exceptedWeeks: 52,
+		},
+		{
+			name:          "last_saturday",
+			calendar:      lastSaturday,
+			year:          2024,
+			excepted:      dateSpan(2023, time.December, 31, 2024, time.December, 29),
+			exceptedWeeks: 52,
+		},
+		{
+			name:          "last_saturday_53_weeks",
+			calendar:      lastSaturday,
+			year:          2022,
+			excepted:      dateSpan(2021, time.December, 26, 2023, time.January, 1),
+			exceptedWeeks: 53,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.excepted, tc.calendar.Year(tc.year))
+			assert.Equal(t, tc.exceptedWeeks, tc.calendar.WeeksIn(tc.year))
+			weeks := tc.calendar.Weeks(tc.year)
+			assert.Len(t, weeks.Spans(), tc.exceptedWeeks)
+			periods := tc.calendar.Periods(tc.year)
+			assert.Len(t, periods.Spans(), 12)
+			assert.Equal(t, tc.excepted.start, periods.Spans()[0].start)
+			assert.Equal(t, tc.excepted.end, periods.Spans()[11].end)
+			quarters := tc.calendar.Quarters(tc.year)
+			assert.Len(t, quarters.Spans(), 4)
+			assert.Equal(t, tc.excepted.Duration(), quarters.Duration())
+		})
+	}
+}
+
+func TestFiscalCalendarPeriods(t *testing.T) {
+	c := newRetailCalendar(t)
+	periods := c.Periods(2024)
+	weeks := make([]int, 0, 12)
+	for _, p := range periods.Spans() {
+		weeks = append(weeks, int(p.Duration()/weekLength))
+	}
+	assert.Equal(t, []int{4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 5}, weeks)
+
+	quarters := c.Quarters(2025)
+	assert.Equal(t, dateSpan(2024, time.February, 4, 2024, time.May, 5), quarters.Spans()[0])
+}
+
+func TestFiscalCalendarAt(t *testing.T) {
+	c := newRetailCalendar(t)
+	testCases := []struct {
+		name string
+		t    time.Time
+
+		excepted       FiscalDate
+		exceptedPeriod Span
+	}{
+		{
+			name:           "first_day",
+			t:              time.Date(2023, time.January, 29, 0, 0, 0, 0, time.UTC),
+			excepted:       FiscalDate{Year: 2024, Quarter: 1, Period: 1, Week: 1},
+			exceptedPeriod: dateSpan(2023, time.January, 29, 2023, time.February, 26),
+		},
+		{
+			name:           "before_first_day",
+			t:              time.Date(2023, time.January, 28, 23, 59, 0, 0, time.UTC),
+			excepted:       FiscalDate{Year: 2023, Quarter: 4, Period: 12, Week: 52},
+			exceptedPeriod: dateSpan(2023, time.January, 1, 2023, time.January, 29),
+		},
+		{
+			name:           "53rd_week",
+			t:              time.Date(2024, time.February, 1, 12, 0, 0, 0, time.UTC),
+			excepted:       FiscalDate{Year: 2024, Quarter: 4, Period: 12, Week: 53},
+			exceptedPeriod: dateSpan(2023, time.December, 31, 2024, time.February, 4),
+		},
+		{
+			name:           "mid_year",
+			t:              time.Date(2023, time.July, 4, 0, 0, 0, 0, time.UTC),
+			excepted:       FiscalDate{Year: 2024, Quarter: 2, Period: 6, Week: 23},
+			exceptedPeriod: dateSpan(2023, time.July, 2, 2023, time.July, 30),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.excepted, c.At(tc.t))
+			assert.Equal(t, tc.exceptedPeriod, c.PeriodAt(tc.t))
+		})
+	}
+}
+
+func TestFiscalCalendarSplit(t *testing.T) {
+	c := newRetailCalendar(t)
+	s := Span{
+		start: time.Date(2024, time.January, 20, 12, 0, 0, 0, time.UTC),
+		end:   time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+	}
+	assert.Equal(t, NewMany(
+		Span{start: s.start, end: time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC)},
+		dateSpan(2024, time.February, 4, 2024, time.March, 3),
+		dateSpan(2024, time.March, 3, 2024, time.March, 10),
+	), c.Split(s))
+	assert.Equal(t, NewMany(), c.Split(Span{}))
+
+	inside := dateSpan(2024, time.March, 4, 2024, time.March, 5)
+	assert.Equal(t, NewMany(
+		inside,
+		Span{start: s.start, end: time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC)},
+		dateSpan(2024, time.February, 4, 2024, time.March, 3),
+		dateSpan(2024, time.March, 3, 2024, time.March, 10),
+	), c.SplitMany(NewMany(inside, s)))
+}
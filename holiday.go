@@ -0,0 +1,187 @@
+package timeinterval
+
+import "time"
+
+// HolidayKind how the date of a holiday is defined.
+type HolidayKind int
+
+const (
+	// FixedDate the same month and day every year.
+	FixedDate HolidayKind = iota
+	// NthWeekday the N-th weekday of the month, for example the 4th Thursday of November.
+	NthWeekday
+	// LastWeekday the last weekday of the month, for example the last Monday of May.
+	LastWeekday
+	// EasterRelative Offset days from the Western (Gregorian) Easter Sunday.
+	EasterRelative
+)
+
+// ObservedShift moving a holiday falling on a weekend to the observed day.
+type ObservedShift int
+
+const (
+	// NoShift the holiday is observed on its date (default).
+	NoShift ObservedShift = iota
+	// SaturdayToFriday Saturday is observed on Friday.
+	SaturdayToFriday
+	// SundayToMonday Sunday is observed on Monday.
+	SundayToMonday
+	// NearestWeekday Saturday is observed on Friday, Sunday on Monday.
+	NearestWeekday
+	// WeekendToMonday Saturday and Sunday are observed on Monday.
+	WeekendToMonday
+)
+
+// HolidayRule rule for calculating the date of a holiday every year.
+type HolidayRule struct {
+	Name string
+	Kind HolidayKind
+	// Month for FixedDate, NthWeekday and LastWeekday.
+	Month time.Month
+	// Day day of the month for FixedDate.
+	Day int
+	// Weekday for NthWeekday and LastWeekday.
+	Weekday time.Weekday
+	// N number of the weekday in the month starting with 1 for NthWeekday.
+	N int
+	// Offset days from Easter Sunday for EasterRelative, negative before Easter.
+	Offset int
+	// Observed shift of the holiday falling on a weekend.
+	Observed ObservedShift
+	// FromYear the first year of the holiday, zero value - the holiday always existed.
+	FromYear int
+	// ToYear the last year of the holiday, zero value - the holiday still exists.
+	ToYear int
+}
+
+// HolidayRules set of holiday rules.
+type HolidayRules []HolidayRule
+
+// USFederalHolidays federal holidays of the United States.
+var USFederalHolidays = HolidayRules{
+	{Name: "New Year's Day", Kind: FixedDate, Month: time.January, Day: 1, Observed: NearestWeekday},
+	{Name: "Birthday of Martin Luther King, Jr.", Kind: NthWeekday, Month: time.January, Weekday: time.Monday, N: 3, FromYear: 1986},
+	{Name: "Washington's Birthday", Kind: NthWeekday, Month: time.February, Weekday: time.Monday, N: 3},
+	{Name: "Memorial Day", Kind: LastWeekday, Month: time.May, Weekday: time.Monday},
+	{Name: "Juneteenth National Independence Day", Kind: FixedDate, Month: time.June, Day: 19, Observed: NearestWeekday, FromYear: 2021},
+	{Name: "Independence Day", Kind: FixedDate, Month: time.July, Day: 4, Observed: NearestWeekday},
+	{Name: "Labor Day", Kind: NthWeekday, Month: time.September, Weekday: time.Monday, N: 1},
+	{Name: "Columbus Day", Kind: NthWeekday, Month: time.October, Weekday: time.Monday, N: 2},
+	{Name: "Veterans Day", Kind: FixedDate, Month: time.November, Day: 11, Observed: NearestWeekday},
+	{Name: "Thanksgiving Day", Kind: NthWeekday, Month: time.November, Weekday: time.Thursday, N: 4},
+	{Name: "Christmas Day", Kind: FixedDate, Month: time.December, Day: 25, Observed: NearestWeekday},
+}
+
+// EasterHolidays Western Christian holidays depending on Easter.
+var EasterHolidays = HolidayRules{
+	{Name: "Good Friday", Kind: EasterRelative, Offset: -2},
+	{Name: "Easter Sunday", Kind: EasterRelative},
+	{Name: "Easter Monday", Kind: EasterRelative, Offset: 1},
+	{Name: "Ascension Day", Kind: EasterRelative, Offset: 39},
+	{Name: "Whit Monday", Kind: EasterRelative, Offset: 50},
+}
+
+// Date the observed date of the holiday in the year, the time is midnight in UTC.
+// Returns false if there is no holiday in the year.
+func (r *HolidayRule) Date(year int) (time.Time, bool) {
+	if (r.FromYear != 0 && year < r.FromYear) || (r.ToYear != 0 && year > r.ToYear) {
+		return time.Time{}, false
+	}
+	var date time.Time
+	switch r.Kind {
+	case FixedDate:
+		date = time.Date(year, r.Month, r.Day, 0, 0, 0, 0, time.UTC)
+		if date.Month() != r.Month {
+			return time.Time{}, false
+		}
+	case NthWeekday:
+		if r.N < 1 {
+			return time.Time{}, false
+		}
+		first := time.Date(year, r.Month, 1, 0, 0, 0, 0, time.UTC)
+		shift := (int(r.Weekday) - int(first.Weekday()) + 7) % 7
+		date = first.AddDate(0, 0, shift+(r.N-1)*7)
+		if date.Month() != r.Month {
+			return time.Time{}, false
+		}
+	case LastWeekday:
+		last := time.Date(year, r.Month+1, 0, 0, 0, 0, 0, time.UTC)
+		shift := (int(last.Weekday()) - int(r.Weekday) + 7) % 7
+		date = last.AddDate(0, 0, -shift)
+	case EasterRelative:
+		month, day := easterSunday(year)
+		date = time.Date(year, month, day+r.Offset, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Time{}, false
+	}
+	return r.Observed.apply(date), true
+}
+
+// Generate all-day time intervals of the holidays from the beginning of fromYear to the end of toYear
+// in the location. Holidays observed in the neighbouring year because of the shift (January 1 on
+// Friday December 31) are included if they fall into the range. The result is sorted and merged.
+func (r HolidayRules) Generate(fromYear, toYear int, loc *time.Location) SpanMany {
+	if loc == nil {
+		loc = time.UTC
+	}
+	window := Span{
+		start: time.Date(fromYear, time.January, 1, 0, 0, 0, 0, loc),
+		end:   time.Date(toYear+1, time.January, 1, 0, 0, 0, 0, loc),
+	}
+	result := NewMany()
+	if !window.start.Before(window.end) {
+		return result
+	}
+	for year := fromYear - 1; year <= toYear+1; year++ {
+		for i := range r {
+			date, ok := r[i].Date(year)
+			if !ok {
+				continue
+			}
+			y, m, d := date.Date()
+			result.AddMany(Span{
+				start: time.Date(y, m, d, 0, 0, 0, 0, loc),
+				end:   time.Date(y, m, d+1, 0, 0, 0, 0, loc),
+			})
+		}
+	}
+	result = result.Intersection(window)
+	return result.Union()
+}
+
+func (o ObservedShift) apply(date time.Time) time.Time {
+	switch date.Weekday() {
+	case time.Saturday:
+		switch o {
+		case SaturdayToFriday, NearestWeekday:
+			return date.AddDate(0, 0, -1)
+		case WeekendToMonday:
+			return date.AddDate(0, 0, 2)
+		}
+	case time.Sunday:
+		switch o {
+		case SundayToMonday, NearestWeekday, WeekendToMonday:
+			return date.AddDate(0, 0, 1)
+		}
+	}
+	return date
+}
+
+// easterSunday date of the Western Easter Sunday in the year (anonymous Gregorian algorithm).
+func easterSunday(year int) (time.Month, int) {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Month(month), day
+}
@@ -0,0 +1,150 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEasterSunday(t *testing.T) {
+	testCases := []struct {
+		year  int
+		month time.Month
+		day   int
+	}{
+		{2019, time.April, 21},
+		{2020, time.April, 12},
+		{2021, time.April, 4},
+		{2024, time.March, 31},
+		{2038, time.April, 25},
+	}
+	for _, tc := range testCases {
+		month, day := easterSunday(tc.year)
+		assert.Equal(t, tc.month, month, tc.year)
+		assert.Equal(t, tc.day, day, tc.year)
+	}
+}
+
+func TestHolidayRuleDate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		rule   HolidayRule
+		year   int
+		wantOk bool
+		want   time.Time
+	}{
+		{
+			name:   "fixed_date",
+			rule:   HolidayRule{Kind: FixedDate, Month: time.November, Day: 11},
+			year:   2020,
+			wantOk: true,
+			want:   time.Date(2020, 11, 11, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "fixed_date_not_exists",
+			rule: HolidayRule{Kind: FixedDate, Month: time.February, Day: 29},
+			year: 2021,
+		},
+		{
+			name:   "nth_weekday",
+			rule:   HolidayRule{Kind: NthWeekday, Month: time.November, Weekday: time.Thursday, N: 4},
+			year:   2020,
+			wantOk: true,
+			want:   time.Date(2020, 11, 26, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "nth_weekday_not_exists",
+			rule: HolidayRule{Kind: NthWeekday, Month: time.February, Weekday: time.Monday, N: 5},
+			year: 2021,
+		},
+		{
+			name:   "last_weekday",
+			rule:   HolidayRule{Kind: LastWeekday, Month: time.May, Weekday: time.Monday},
+			year:   2020,
+			wantOk: true,
+			want:   time.Date(2020, 5, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "easter_relative",
+			rule:   HolidayRule{Kind: EasterRelative, Offset: -2},
+			year:   2020,
+			wantOk: true,
+			want:   time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "saturday_to_friday",
+			rule:   HolidayRule{Kind: FixedDate, Month: time.December, Day: 25, Observed: SaturdayToFriday},
+			year:   2021,
+			wantOk: true,
+			want:   time.Date(2021, 12, 24, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "sunday_to_monday",
+			rule:   HolidayRule{Kind: FixedDate, Month: time.July, Day: 4, Observed: SundayToMonday},
+			year:   2021,
+			wantOk: true,
+			want:   time.Date(2021, 7, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "weekend_to_monday",
+			rule:   HolidayRule{Kind: FixedDate, Month: time.December, Day: 25, Observed: WeekendToMonday},
+			year:   2021,
+			wantOk: true,
+			want:   time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "before_first_year",
+			rule: HolidayRule{Kind: FixedDate, Month: time.June, Day: 19, FromYear: 2021},
+			year: 2020,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			date, ok := tc.rule.Date(tc.year)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.want, date)
+		})
+	}
+}
+
+func TestHolidayRulesGenerate(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	holidays := USFederalHolidays.Generate(2021, 2021, newYork)
+	var dates []string
+	for _, sp := range holidays.Spans() {
+		assert.Equal(t, newYork, sp.Start().Location())
+		assert.Equal(t, 0, sp.Start().Hour())
+		assert.True(t, sp.End().Equal(sp.Start().AddDate(0, 0, 1)))
+		dates = append(dates, sp.Start().Format("2006-01-02"))
+	}
+	assert.Equal(t, []string{
+		"2021-01-01", "2021-01-18", "2021-02-15", "2021-05-31", "2021-06-18", "2021-07-05",
+		"2021-09-06", "2021-10-11", "2021-11-11", "2021-11-25", "2021-12-24", "2021-12-31",
+	}, dates)
+
+	holidays = USFederalHolidays.Generate(2022, 2022, newYork)
+	assert.Equal(t, "2022-01-17", holidays.Spans()[0].Start().Format("2006-01-02"))
+
+	easter := EasterHolidays.Generate(2020, 2020, time.UTC)
+	assert.Equal(t, NewMany(
+		Span{
+			time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 4, 11, 0, 0, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 4, 12, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 4, 14, 0, 0, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 5, 21, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 5, 22, 0, 0, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 6, 2, 0, 0, 0, 0, time.UTC)},
+	), easter)
+
+	assert.Equal(t, NewMany(), EasterHolidays.Generate(2021, 2020, time.UTC))
+}
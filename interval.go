@@ -0,0 +1,157 @@
+package timeinterval
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+)
+
+// Comparator ordering of the values of an interval domain:
+// negative if a < b, zero if a == b, positive if a > b.
+type Comparator[T any] interface {
+	Compare(a, b T) int
+}
+
+// Ordered natural ordering of cmp.Ordered types (integers, floats, strings).
+type Ordered[T cmp.Ordered] struct{}
+
+// Compare implementation interface Comparator for Ordered.
+func (Ordered[T]) Compare(a, b T) int {
+	return cmp.Compare(a, b)
+}
+
+// Chronological ordering of time.Time by instant, the domain of Span.
+type Chronological struct{}
+
+// Compare implementation interface Comparator for Chronological.
+func (Chronological) Compare(a, b time.Time) int {
+	return a.Compare(b)
+}
+
+// Interval half-open interval [start, end) of the domain T ordered by C.
+// Span is Interval[time.Time, Chronological] with tolerance support.
+type Interval[T any, C Comparator[T]] struct {
+	start T
+	end   T
+}
+
+// timeInterval domain of Span.
+type timeInterval = Interval[time.Time, Chronological]
+
+// NewInterval initialization of a new interval.
+// Returns *IntervalError wrapping ErrInverted or ErrEmpty if start is not less than end.
+func NewInterval[T any, C Comparator[T]](start, end T) (Interval[T, C], error) {
+	var reason error
+	switch r := compare[T, C](start, end); {
+	case r == 0:
+		reason = ErrEmpty
+	case r > 0:
+		reason = ErrInverted
+	}
+	if reason != nil {
+		return Interval[T, C]{}, &IntervalError[T]{
+			Start: start,
+			End:   end,
+			Err:   reason,
+		}
+	}
+	return Interval[T, C]{
+		start: start,
+		end:   end,
+	}, nil
+}
+
+// Start returning start of the interval.
+func (i *Interval[T, C]) Start() T {
+	return i.start
+}
+
+// End returning end of the interval.
+func (i *Interval[T, C]) End() T {
+	return i.end
+}
+
+// String implementation interface stringer for Interval.
+func (i *Interval[T, C]) String() string {
+	return fmt.Sprintf("%v - %v", i.start, i.end)
+}
+
+// IsEmpty the interval contains no values (start is not less than end).
+func (i *Interval[T, C]) IsEmpty() bool {
+	return compare[T, C](i.start, i.end) >= 0
+}
+
+// Equal full equals of two intervals.
+func (i *Interval[T, C]) Equal(input Interval[T, C]) bool {
+	return compare[T, C](i.start, input.start) == 0 && compare[T, C](i.end, input.end) == 0
+}
+
+// IsIntersection check for intersection of intervals, intervals touching at the boundary do not intersect.
+func (i *Interval[T, C]) IsIntersection(input Interval[T, C]) bool {
+	return compare[T, C](input.end, i.start) > 0 && compare[T, C](i.end, input.start) > 0
+}
+
+// IsContains check contains interval.
+func (i *Interval[T, C]) IsContains(input Interval[T, C]) bool {
+	return compare[T, C](i.start, input.start) <= 0 && compare[T, C](i.end, input.end) >= 0
+}
+
+// Intersection intersection of two intervals, the zero value if they do not intersect.
+func (i *Interval[T, C]) Intersection(input Interval[T, C]) Interval[T, C] {
+	if !i.IsIntersection(input) {
+		return Interval[T, C]{}
+	}
+	result := input
+	if compare[T, C](i.start, input.start) > 0 {
+		result.start = i.start
+	}
+	if compare[T, C](i.end, input.end) < 0 {
+		result.end = i.end
+	}
+	return result
+}
+
+// Union union of two intervals, intersecting or touching intervals are merged.
+func (i *Interval[T, C]) Union(input Interval[T, C]) IntervalSet[T, C] {
+	if i.touches(input) {
+		return NewIntervalSet(i.hull(input))
+	}
+	return NewIntervalSet(*i, input)
+}
+
+// Except difference of intervals - from input (i \ input).
+func (i *Interval[T, C]) Except(input Interval[T, C]) IntervalSet[T, C] {
+	if !i.IsIntersection(input) {
+		return NewIntervalSet(*i)
+	}
+	result := NewIntervalSet[T, C]()
+	if compare[T, C](input.start, i.start) > 0 {
+		result.intervals = append(result.intervals, Interval[T, C]{start: i.start, end: input.start})
+	}
+	if compare[T, C](i.end, input.end) > 0 {
+		result.intervals = append(result.intervals, Interval[T, C]{start: input.end, end: i.end})
+	}
+	return result
+}
+
+// touches intervals intersect or touch at the boundary.
+func (i *Interval[T, C]) touches(input Interval[T, C]) bool {
+	return compare[T, C](i.start, input.end) <= 0 && compare[T, C](i.end, input.start) >= 0
+}
+
+// hull the smallest interval containing both intervals.
+func (i *Interval[T, C]) hull(input Interval[T, C]) Interval[T, C] {
+	result := *i
+	if compare[T, C](input.start, result.start) < 0 {
+		result.start = input.start
+	}
+	if compare[T, C](input.end, result.end) > 0 {
+		result.end = input.end
+	}
+	return result
+}
+
+func compare[T any, C Comparator[T]](a, b T) int {
+	var c C
+	return c.Compare(a, b)
+}
@@ -0,0 +1,134 @@
+package timeinterval
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type intInterval = Interval[int, Ordered[int]]
+
+func ints(start, end int) intInterval {
+	return intInterval{start: start, end: end}
+}
+
+func intSet(intervals ...intInterval) IntervalSet[int, Ordered[int]] {
+	return NewIntervalSet(intervals...)
+}
+
+func TestNewInterval(t *testing.T) {
+	i, err := NewInterval[int, Ordered[int]](1, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, i.Start())
+	assert.Equal(t, 5, i.End())
+
+	_, err = NewInterval[int, Ordered[int]](5, 5)
+	assert.True(t, errors.Is(err, ErrEmpty))
+	_, err = NewInterval[int, Ordered[int]](5, 1)
+	assert.True(t, errors.Is(err, ErrInverted))
+	var intervalErr *IntervalError[int]
+	assert.True(t, errors.As(err, &intervalErr))
+	assert.Equal(t, 5, intervalErr.Start)
+	assert.Equal(t, 1, intervalErr.End)
+	assert.Equal(t, "invalid interval 5 - 1: "+ErrInverted.Error(), err.Error())
+}
+
+func TestInterval(t *testing.T) {
+	testCases := []struct {
+		name  string
+		i     intInterval
+		input intInterval
+
+		exceptedIsIntersection bool
+		exceptedIsContains     bool
+		exceptedIntersection   intInterval
+		exceptedUnion          IntervalSet[int, Ordered[int]]
+		exceptedExcept         IntervalSet[int, Ordered[int]]
+	}{
+		{
+			name:                   "overlap",
+			i:                      ints(0, 10),
+			input:                  ints(5, 15),
+			exceptedIsIntersection: true,
+			exceptedIntersection:   ints(5, 10),
+			exceptedUnion:          intSet(ints(0, 15)),
+			exceptedExcept:         intSet(ints(0, 5)),
+		},
+		{
+			name:                   "inside",
+			i:                      ints(0, 10),
+			input:                  ints(3, 6),
+			exceptedIsIntersection: true,
+			exceptedIsContains:     true,
+			exceptedIntersection:   ints(3, 6),
+			exceptedUnion:          intSet(ints(0, 10)),
+			exceptedExcept:         intSet(ints(0, 3), ints(6, 10)),
+		},
+		{
+			name:           "touching",
+			i:              ints(0, 10),
+			input:          ints(10, 20),
+			exceptedUnion:  intSet(ints(0, 20)),
+			exceptedExcept: intSet(ints(0, 10)),
+		},
+		{
+			name:           "apart",
+			i:              ints(-10, -5),
+			input:          ints(0, 10),
+			exceptedUnion:  intSet(ints(-10, -5), ints(0, 10)),
+			exceptedExcept: intSet(ints(-10, -5)),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exceptedIsIntersection, tc.i.IsIntersection(tc.input))
+			assert.Equal(t, tc.exceptedIsContains, tc.i.IsContains(tc.input))
+			assert.Equal(t, tc.exceptedIntersection, tc.i.Intersection(tc.input))
+			assert.Equal(t, tc.exceptedUnion, tc.i.Union(tc.input))
+			assert.Equal(t, tc.exceptedExcept, tc.i.Except(tc.input))
+			assert.True(t, tc.i.Equal(tc.i))
+		})
+	}
+}
+
+func TestIntervalSet(t *testing.T) {
+	set := intSet(ints(20, 30), ints(0, 10), ints(5, 12), ints(12, 15), ints(40, 40))
+
+	assert.Equal(t, intSet(ints(0, 15), ints(20, 30)), set.Union())
+	assert.Equal(t, intSet(ints(20, 30), ints(0, 10), ints(5, 12), ints(12, 15), ints(40, 40)), set)
+	assert.Equal(t, intSet(ints(0, 15), ints(20, 35)), set.Union(intSet(ints(30, 35))))
+	assert.Equal(t, intSet(ints(0, 8), ints(25, 30)), set.Except(ints(8, 25)))
+	assert.Equal(t, intSet(ints(20, 25), ints(8, 10), ints(8, 12), ints(12, 15)), set.Intersection(ints(8, 25)))
+	assert.True(t, set.IsIntersection(ints(14, 16)))
+	assert.False(t, set.IsIntersection(ints(15, 20)))
+	assert.True(t, set.IsContains(ints(21, 29)))
+	assert.True(t, set.Equal(ints(12, 15)))
+
+	set.Sort(Descending)
+	assert.Equal(t, ints(40, 40), set.Intervals()[0])
+	empty := intSet()
+	assert.Equal(t, []intInterval{}, empty.Intervals())
+}
+
+// caseInsensitive ordering of strings ignoring case.
+type caseInsensitive struct{}
+
+func (caseInsensitive) Compare(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func TestIntervalComparator(t *testing.T) {
+	a, err := NewInterval[string, caseInsensitive]("a", "M")
+	assert.NoError(t, err)
+	b, err := NewInterval[string, caseInsensitive]("k", "Z")
+	assert.NoError(t, err)
+
+	assert.Equal(t, Interval[string, caseInsensitive]{start: "k", end: "M"}, a.Intersection(b))
+	assert.Equal(t, NewIntervalSet(Interval[string, caseInsensitive]{start: "a", end: "Z"}), a.Union(b))
+
+	_, err = NewInterval[string, caseInsensitive]("B", "b")
+	assert.True(t, errors.Is(err, ErrEmpty))
+}
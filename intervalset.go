@@ -0,0 +1,182 @@
+package timeinterval
+
+import (
+	"fmt"
+	"sort"
+)
+
+// IntervalSet model containing more than one interval of the domain T ordered by C.
+// SpanMany is IntervalSet[time.Time, Chronological] with tolerance support.
+type IntervalSet[T any, C Comparator[T]] struct {
+	intervals []Interval[T, C]
+}
+
+// NewIntervalSet initialization for multiple intervals.
+func NewIntervalSet[T any, C Comparator[T]](intervals ...Interval[T, C]) IntervalSet[T, C] {
+	if len(intervals) == 0 {
+		return IntervalSet[T, C]{
+			intervals: []Interval[T, C]{},
+		}
+	}
+	return IntervalSet[T, C]{
+		intervals: intervals,
+	}
+}
+
+// Add adding intervals to IntervalSet.
+func (s *IntervalSet[T, C]) Add(intervals ...Interval[T, C]) {
+	s.intervals = append(s.intervals, intervals...)
+}
+
+// String implementation interface stringer for IntervalSet.
+func (s *IntervalSet[T, C]) String() string {
+	str := "["
+	for _, i := range s.intervals {
+		str += fmt.Sprintf("\n\t%v - %v", i.start, i.end)
+	}
+	str += "\n]"
+	return str
+}
+
+// Intervals get an array of intervals.
+func (s *IntervalSet[T, C]) Intervals() []Interval[T, C] {
+	if s.intervals == nil {
+		return []Interval[T, C]{}
+	}
+	return s.intervals
+}
+
+// Sort sorting intervals by start.
+
+// st - sorting options:
+// Ascending sort Ascending (default)
+// Descending sort descending
+func (s *IntervalSet[T, C]) Sort(st ...SortType) {
+	sortIntervals(s.intervals, st...)
+}
+
+// Equal full comparison of IntervalSet with one interval.
+// If there is at least one match, return true.
+func (s *IntervalSet[T, C]) Equal(input Interval[T, C]) bool {
+	return s.anyOf(func(i Interval[T, C]) bool {
+		return i.Equal(input)
+	})
+}
+
+// IsIntersection checking for intersection of an interval with one of IntervalSet.
+// If there is at least one match, return true.
+func (s *IntervalSet[T, C]) IsIntersection(input Interval[T, C]) bool {
+	return s.anyOf(func(i Interval[T, C]) bool {
+		return i.IsIntersection(input)
+	})
+}
+
+// IsContains checking for contains of IntervalSet with one interval.
+// If there is at least one match, return true.
+func (s *IntervalSet[T, C]) IsContains(input Interval[T, C]) bool {
+	return s.anyOf(func(i Interval[T, C]) bool {
+		return i.IsContains(input)
+	})
+}
+
+// Intersection intersecting IntervalSet with one interval.
+func (s *IntervalSet[T, C]) Intersection(input Interval[T, C]) IntervalSet[T, C] {
+	var result []Interval[T, C]
+	for _, i := range s.intervals {
+		if in := i.Intersection(input); !in.IsEmpty() {
+			result = append(result, in)
+		}
+	}
+	return NewIntervalSet(result...)
+}
+
+// Except difference between each interval of IntervalSet and input (s[i] \ input).
+// The result is sorted and merged, the receiver is not modified.
+func (s *IntervalSet[T, C]) Except(input Interval[T, C]) IntervalSet[T, C] {
+	return s.flatUnion(func(i Interval[T, C]) []Interval[T, C] {
+		ex := i.Except(input)
+		return ex.intervals
+	})
+}
+
+// Union concatenation IntervalSet of array IntervalSet.
+// The result is sorted and merged, intersecting or touching intervals are merged and empty ones are dropped.
+// The receiver is not modified.
+func (s *IntervalSet[T, C]) Union(input ...IntervalSet[T, C]) IntervalSet[T, C] {
+	all := append([]Interval[T, C](nil), s.intervals...)
+	for _, inp := range input {
+		all = append(all, inp.intervals...)
+	}
+	return unionIntervals(all)
+}
+
+// anyOf at least one interval satisfies the predicate.
+func (s *IntervalSet[T, C]) anyOf(predicate func(i Interval[T, C]) bool) bool {
+	for _, i := range s.intervals {
+		if predicate(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// filter intervals satisfying the predicate, the receiver is not modified.
+func (s *IntervalSet[T, C]) filter(predicate func(i Interval[T, C]) bool) IntervalSet[T, C] {
+	var result []Interval[T, C]
+	for _, i := range s.intervals {
+		if predicate(i) {
+			result = append(result, i)
+		}
+	}
+	return NewIntervalSet(result...)
+}
+
+// flatUnion sorted and merged union of the intervals produced by f from every interval.
+func (s *IntervalSet[T, C]) flatUnion(f func(i Interval[T, C]) []Interval[T, C]) IntervalSet[T, C] {
+	var result []Interval[T, C]
+	for _, i := range s.intervals {
+		result = append(result, f(i)...)
+	}
+	return unionIntervals(result)
+}
+
+// unionIntervals sorting and merging of intervals in place.
+func unionIntervals[T any, C Comparator[T]](intervals []Interval[T, C]) IntervalSet[T, C] {
+	sortIntervals(intervals)
+	return NewIntervalSet(mergeSorted(intervals, func(last, next Interval[T, C]) bool {
+		return next.touches(last)
+	})...)
+}
+
+// sortIntervals sorting intervals by start in place.
+func sortIntervals[T any, C Comparator[T]](intervals []Interval[T, C], st ...SortType) {
+	if len(intervals) == 0 {
+		return
+	}
+	if len(st) > 0 && st[0] == Descending {
+		sort.Slice(intervals, func(i, j int) bool {
+			return compare[T, C](intervals[i].start, intervals[j].start) > 0
+		})
+		return
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return compare[T, C](intervals[i].start, intervals[j].start) < 0
+	})
+}
+
+// mergeSorted merging of intervals sorted by start, next is merged into last if merge reports true.
+// Empty intervals are dropped.
+func mergeSorted[T any, C Comparator[T]](sorted []Interval[T, C], merge func(last, next Interval[T, C]) bool) []Interval[T, C] {
+	var result []Interval[T, C]
+	for _, i := range sorted {
+		if i.IsEmpty() {
+			continue
+		}
+		if last := len(result) - 1; last >= 0 && merge(result[last], i) {
+			result[last] = result[last].hull(i)
+			continue
+		}
+		result = append(result, i)
+	}
+	return result
+}
@@ -0,0 +1,56 @@
+package timeinterval
+
+import "time"
+
+// Close merging time intervals separated by gaps not longer than g (morphological closing),
+// the same as UnionWithin(Within(g)) and Dilate(g/2) followed by Erode(g/2).
+// The result is sorted and merged, the receiver is not modified.
+func (s *SpanMany) Close(g time.Duration) SpanMany {
+	return s.UnionWithin(Within(g))
+}
+
+// Open removing time intervals shorter than m (morphological opening).
+// The time intervals are sorted and merged before removing, the receiver is not modified.
+func (s *SpanMany) Open(m time.Duration) SpanMany {
+	n := s.normalized()
+	var result []Span
+	for _, sp := range n.spans {
+		if sp.Duration() >= m {
+			result = append(result, sp)
+		}
+	}
+	return NewMany(result...)
+}
+
+// Dilate extending every time interval by d in both directions.
+// Negative d is the same as Erode(-d). The result is sorted and merged, the receiver is not modified.
+func (s *SpanMany) Dilate(d time.Duration) SpanMany {
+	if d < 0 {
+		return s.Erode(-d)
+	}
+	result := NewMany()
+	for _, sp := range s.spans {
+		if sp.start.Before(sp.end) {
+			result.spans = append(result.spans, Span{start: sp.start.Add(-d), end: sp.end.Add(d)})
+		}
+	}
+	return result.Union()
+}
+
+// Erode shrinking every time interval by d from both sides, time intervals not longer than 2d disappear.
+// Negative d is the same as Dilate(-d). The time intervals are sorted and merged before shrinking,
+// the receiver is not modified.
+func (s *SpanMany) Erode(d time.Duration) SpanMany {
+	if d < 0 {
+		return s.Dilate(-d)
+	}
+	n := s.normalized()
+	var result []Span
+	for _, sp := range n.spans {
+		start, end := sp.start.Add(d), sp.end.Add(-d)
+		if start.Before(end) {
+			result = append(result, Span{start: start, end: end})
+		}
+	}
+	return NewMany(result...)
+}
@@ -0,0 +1,84 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMorphology(t *testing.T) {
+	noisy := NewMany(
+		minuteSpan(30, 31),
+		minuteSpan(0, 10),
+		minuteSpan(12, 20),
+		minuteSpan(5, 11),
+		minuteSpan(40, 60),
+	)
+	testCases := []struct {
+		name      string
+		operation func(s *SpanMany) SpanMany
+
+		excepted SpanMany
+	}{
+		{
+			name:      "close",
+			operation: func(s *SpanMany) SpanMany { return s.Close(2 * time.Minute) },
+			excepted:  NewMany(minuteSpan(0, 20), minuteSpan(30, 31), minuteSpan(40, 60)),
+		},
+		{
+			name:      "close_gap_equal",
+			operation: func(s *SpanMany) SpanMany { return s.Close(time.Minute) },
+			excepted:  NewMany(minuteSpan(0, 20), minuteSpan(30, 31), minuteSpan(40, 60)),
+		},
+		{
+			name:      "close_gap_longer",
+			operation: func(s *SpanMany) SpanMany { return s.Close(time.Minute - 1) },
+			excepted:  NewMany(minuteSpan(0, 11), minuteSpan(12, 20), minuteSpan(30, 31), minuteSpan(40, 60)),
+		},
+		{
+			name:      "close_as_union_within",
+			operation: func(s *SpanMany) SpanMany { return s.UnionWithin(Within(time.Minute)) },
+			excepted:  NewMany(minuteSpan(0, 20), minuteSpan(30, 31), minuteSpan(40, 60)),
+		},
+		{
+			name:      "open",
+			operation: func(s *SpanMany) SpanMany { return s.Open(10 * time.Minute) },
+			excepted:  NewMany(minuteSpan(0, 11), minuteSpan(40, 60)),
+		},
+		{
+			name:      "dilate",
+			operation: func(s *SpanMany) SpanMany { return s.Dilate(time.Minute) },
+			excepted:  NewMany(minuteSpan(-1, 21), minuteSpan(29, 32), minuteSpan(39, 61)),
+		},
+		{
+			name:      "erode",
+			operation: func(s *SpanMany) SpanMany { return s.Erode(time.Minute) },
+			excepted:  NewMany(minuteSpan(1, 10), minuteSpan(13, 19), minuteSpan(41, 59)),
+		},
+		{
+			name:      "dilate_negative",
+			operation: func(s *SpanMany) SpanMany { return s.Dilate(-time.Minute) },
+			excepted:  NewMany(minuteSpan(1, 10), minuteSpan(13, 19), minuteSpan(41, 59)),
+		},
+		{
+			name:      "closing_by_dilate_erode",
+			operation: func(s *SpanMany) SpanMany { d := s.Dilate(time.Minute); return d.Erode(time.Minute) },
+			excepted:  NewMany(minuteSpan(0, 20), minuteSpan(30, 31), minuteSpan(40, 60)),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			input := NewMany(append([]Span(nil), noisy.spans...)...)
+			result := tc.operation(&input)
+			assert.Equal(t, tc.excepted, result)
+			assert.Equal(t, noisy, input)
+		})
+	}
+	empty := NewMany()
+	assert.Equal(t, NewMany(), empty.Close(time.Minute))
+	assert.Equal(t, NewMany(), empty.Open(time.Minute))
+	assert.Equal(t, NewMany(), empty.Dilate(time.Minute))
+	assert.Equal(t, NewMany(), empty.Erode(time.Minute))
+}
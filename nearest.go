@@ -0,0 +1,72 @@
+package timeinterval
+
+import (
+	"sort"
+	"time"
+)
+
+// Direction side of a time or a time interval to search for the nearest time intervals.
+type Direction int
+
+const (
+	// Either time intervals on both sides and intersecting ones (default).
+	Either Direction = iota
+	// BeforeOnly time intervals ending not after the time or the start of the time interval.
+	BeforeOnly
+	// AfterOnly time intervals starting not before the time or the end of the time interval.
+	AfterOnly
+)
+
+// Distance gap between two time intervals, 0 if they intersect or touch.
+func (s *Span) Distance(input Span) time.Duration {
+	switch {
+	case input.start.After(s.end):
+		return input.start.Sub(s.end)
+	case s.start.After(input.end):
+		return s.start.Sub(input.end)
+	}
+	return 0
+}
+
+// Nearest k time intervals nearest to t, the ones containing t have distance 0.
+// The result is ordered by distance, time intervals at the same distance by start.
+
+// dir - side of t to search, Either by default.
+func (s *SpanMany) Nearest(t time.Time, k int, dir ...Direction) SpanMany {
+	return s.NearestSpan(Span{start: t, end: t}, k, dir...)
+}
+
+// NearestSpan k time intervals nearest to the time interval input by Distance.
+// The result is ordered by distance, time intervals at the same distance by start.
+
+// dir - side of input to search, Either by default.
+func (s *SpanMany) NearestSpan(input Span, k int, dir ...Direction) SpanMany {
+	d := Either
+	if len(dir) > 0 {
+		d = dir[0]
+	}
+	var candidates []Span
+	for _, sp := range s.spans {
+		switch {
+		case d == BeforeOnly && sp.end.After(input.start):
+			continue
+		case d == AfterOnly && sp.start.Before(input.end):
+			continue
+		}
+		candidates = append(candidates, sp)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		di, dj := input.Distance(candidates[i]), input.Distance(candidates[j])
+		if di != dj {
+			return di < dj
+		}
+		return candidates[i].start.Before(candidates[j].start)
+	})
+	if k < 0 {
+		k = 0
+	}
+	if k < len(candidates) {
+		candidates = candidates[:k]
+	}
+	return NewMany(candidates...)
+}
@@ -0,0 +1,101 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpanDistance(t *testing.T) {
+	testCases := []struct {
+		name  string
+		s     Span
+		input Span
+
+		excepted time.Duration
+	}{
+		{name: "after", s: minuteSpan(0, 10), input: minuteSpan(15, 20), excepted: 5 * time.Minute},
+		{name: "before", s: minuteSpan(15, 20), input: minuteSpan(0, 10), excepted: 5 * time.Minute},
+		{name: "touching", s: minuteSpan(0, 10), input: minuteSpan(10, 20), excepted: 0},
+		{name: "overlap", s: minuteSpan(0, 10), input: minuteSpan(5, 20), excepted: 0},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.excepted, tc.s.Distance(tc.input))
+		})
+	}
+}
+
+func TestNearest(t *testing.T) {
+	bookings := NewMany(minuteSpan(60, 70), minuteSpan(0, 10), minuteSpan(25, 35), minuteSpan(40, 50))
+	testCases := []struct {
+		name  string
+		input Span
+		k     int
+		dir   Direction
+
+		excepted SpanMany
+	}{
+		{
+			name:     "either",
+			input:    minuteSpan(20, 20),
+			k:        2,
+			excepted: NewMany(minuteSpan(25, 35), minuteSpan(0, 10)),
+		},
+		{
+			name:     "either_tie_by_start",
+			input:    minuteSpan(55, 55),
+			k:        2,
+			excepted: NewMany(minuteSpan(40, 50), minuteSpan(60, 70)),
+		},
+		{
+			name:     "containing",
+			input:    minuteSpan(30, 30),
+			k:        1,
+			excepted: NewMany(minuteSpan(25, 35)),
+		},
+		{
+			name:     "before_only",
+			input:    minuteSpan(30, 30),
+			k:        3,
+			dir:      BeforeOnly,
+			excepted: NewMany(minuteSpan(0, 10)),
+		},
+		{
+			name:     "after_only",
+			input:    minuteSpan(30, 30),
+			k:        3,
+			dir:      AfterOnly,
+			excepted: NewMany(minuteSpan(40, 50), minuteSpan(60, 70)),
+		},
+		{
+			name:     "slot",
+			input:    minuteSpan(12, 22),
+			k:        10,
+			excepted: NewMany(minuteSpan(0, 10), minuteSpan(25, 35), minuteSpan(40, 50), minuteSpan(60, 70)),
+		},
+		{
+			name:     "slot_after_only",
+			input:    minuteSpan(12, 30),
+			k:        1,
+			dir:      AfterOnly,
+			excepted: NewMany(minuteSpan(40, 50)),
+		},
+		{
+			name:     "zero_k",
+			input:    minuteSpan(12, 30),
+			excepted: NewMany(),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.excepted, bookings.NearestSpan(tc.input, tc.k, tc.dir))
+			if tc.input.start.Equal(tc.input.end) {
+				assert.Equal(t, tc.excepted, bookings.Nearest(tc.input.start, tc.k, tc.dir))
+			}
+		})
+	}
+}
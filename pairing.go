@@ -0,0 +1,162 @@
+package timeinterval
+
+import (
+	"sort"
+	"time"
+)
+
+// EventKind kind of a device event.
+type EventKind int
+
+const (
+	// EventStart beginning of the activity.
+	EventStart EventKind = iota
+	// EventStop end of the activity.
+	EventStop
+)
+
+// Event START or STOP event with its timestamp.
+type Event struct {
+	Time time.Time
+	Kind EventKind
+}
+
+// UnmatchedStartPolicy what to do with a START without STOP.
+type UnmatchedStartPolicy int
+
+const (
+	// DropUnmatched the START without STOP is ignored (default).
+	DropUnmatched UnmatchedStartPolicy = iota
+	// CloseAtCutoff the time interval is closed Cutoff after the START, but not later than the next START.
+	CloseAtCutoff
+	// CloseAtNextStart the time interval is closed at the next START, the last START is ignored.
+	CloseAtNextStart
+)
+
+// DuplicateStartPolicy which of the duplicated STARTs opens the time interval.
+type DuplicateStartPolicy int
+
+const (
+	// KeepFirstStart the first START is used, duplicates are ignored (default).
+	KeepFirstStart DuplicateStartPolicy = iota
+	// KeepLastStart the last duplicate START is used.
+	KeepLastStart
+)
+
+// PairingOptions settings for pairing START and STOP events.
+type PairingOptions struct {
+	Unmatched UnmatchedStartPolicy
+	// Cutoff maximum length of the time interval closed with CloseAtCutoff.
+	Cutoff    time.Duration
+	Duplicate DuplicateStartPolicy
+	// DuplicateWindow a START within DuplicateWindow after the open START is its duplicate,
+	// a later one means that the STOP of the open START was lost.
+	DuplicateWindow time.Duration
+	// Tolerance events arriving out of order by no more than Tolerance are put in place,
+	// events arriving later are dropped.
+	Tolerance time.Duration
+}
+
+// PairingReport diagnostics of pairing events.
+type PairingReport struct {
+	// Paired number of STARTs matched with a STOP.
+	Paired int
+	// UnmatchedStarts number of STARTs without STOP.
+	UnmatchedStarts int
+	// Closed number of unmatched STARTs closed according to the policy.
+	Closed int
+	// UnmatchedStops number of STOPs without START.
+	UnmatchedStops int
+	// DuplicateStarts number of duplicated STARTs.
+	DuplicateStarts int
+	// Reordered number of events put in place within the tolerance.
+	Reordered int
+	// Late number of events dropped because they were out of order more than the tolerance.
+	Late int
+}
+
+// PairEvents building time intervals from a sequence of START and STOP events in the order of arrival.
+// At the same timestamp STOP goes before START, so back to back activities are not merged.
+func PairEvents(events []Event, opts ...PairingOptions) (SpanMany, PairingReport) {
+	var (
+		opt    PairingOptions
+		report PairingReport
+	)
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	ordered := make([]Event, 0, len(events))
+	var latest time.Time
+	for i, e := range events {
+		if i > 0 && e.Time.Before(latest) {
+			if latest.Sub(e.Time) > opt.Tolerance {
+				report.Late++
+				continue
+			}
+			report.Reordered++
+		}
+		if i == 0 || e.Time.After(latest) {
+			latest = e.Time
+		}
+		ordered = append(ordered, e)
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Time.Equal(ordered[j].Time) {
+			return ordered[i].Kind == EventStop && ordered[j].Kind == EventStart
+		}
+		return ordered[i].Time.Before(ordered[j].Time)
+	})
+
+	var (
+		spans []Span
+		open  bool
+		start time.Time
+	)
+	closeUnmatched := func(next *time.Time) {
+		report.UnmatchedStarts++
+		end := time.Time{}
+		switch opt.Unmatched {
+		case CloseAtCutoff:
+			end = start.Add(opt.Cutoff)
+			if next != nil && next.Before(end) {
+				end = *next
+			}
+		case CloseAtNextStart:
+			if next != nil {
+				end = *next
+			}
+		}
+		if start.Before(end) {
+			report.Closed++
+			spans = append(spans, Span{start: start, end: end})
+		}
+	}
+	for _, e := range ordered {
+		switch {
+		case e.Kind == EventStop && open:
+			report.Paired++
+			open = false
+			if start.Before(e.Time) {
+				spans = append(spans, Span{start: start, end: e.Time})
+			}
+		case e.Kind == EventStop:
+			report.UnmatchedStops++
+		case !open:
+			open, start = true, e.Time
+		case e.Time.Sub(start) <= opt.DuplicateWindow:
+			report.DuplicateStarts++
+			if opt.Duplicate == KeepLastStart {
+				start = e.Time
+			}
+		default:
+			next := e.Time
+			closeUnmatched(&next)
+			start = e.Time
+		}
+	}
+	if open {
+		closeUnmatched(nil)
+	}
+	return NewMany(spans...), report
+}
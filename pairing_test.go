@@ -0,0 +1,111 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func events(kinds string, m ...int) []Event {
+	times := minutes(m...)
+	result := make([]Event, 0, len(times))
+	for i, t := range times {
+		kind := EventStart
+		if kinds[i] == 'E' {
+			kind = EventStop
+		}
+		result = append(result, Event{Time: t, Kind: kind})
+	}
+	return result
+}
+
+func TestPairEvents(t *testing.T) {
+	testCases := []struct {
+		name   string
+		events []Event
+		opts   []PairingOptions
+
+		excepted       SpanMany
+		exceptedReport PairingReport
+	}{
+		{
+			name:           "paired",
+			events:         events("SESE", 0, 10, 20, 30),
+			excepted:       NewMany(minuteSpan(0, 10), minuteSpan(20, 30)),
+			exceptedReport: PairingReport{Paired: 2},
+		},
+		{
+			name:           "unmatched_drop",
+			events:         events("SSE", 0, 20, 30),
+			excepted:       NewMany(minuteSpan(20, 30)),
+			exceptedReport: PairingReport{Paired: 1, UnmatchedStarts: 1},
+		},
+		{
+			name:           "unmatched_close_at_next_start",
+			events:         events("SSES", 0, 20, 30, 40),
+			opts:           []PairingOptions{{Unmatched: CloseAtNextStart}},
+			excepted:       NewMany(minuteSpan(0, 20), minuteSpan(20, 30)),
+			exceptedReport: PairingReport{Paired: 1, UnmatchedStarts: 2, Closed: 1},
+		},
+		{
+			name:           "unmatched_close_at_cutoff",
+			events:         events("SSESS", 0, 20, 30, 40, 42),
+			opts:           []PairingOptions{{Unmatched: CloseAtCutoff, Cutoff: 5 * time.Minute}},
+			excepted:       NewMany(minuteSpan(0, 5), minuteSpan(20, 30), minuteSpan(40, 42), minuteSpan(42, 47)),
+			exceptedReport: PairingReport{Paired: 1, UnmatchedStarts: 3, Closed: 3},
+		},
+		{
+			name:           "duplicate_keep_first",
+			events:         events("SSE", 0, 1, 10),
+			opts:           []PairingOptions{{DuplicateWindow: 2 * time.Minute}},
+			excepted:       NewMany(minuteSpan(0, 10)),
+			exceptedReport: PairingReport{Paired: 1, DuplicateStarts: 1},
+		},
+		{
+			name:           "duplicate_keep_last",
+			events:         events("SSE", 0, 1, 10),
+			opts:           []PairingOptions{{DuplicateWindow: 2 * time.Minute, Duplicate: KeepLastStart}},
+			excepted:       NewMany(minuteSpan(1, 10)),
+			exceptedReport: PairingReport{Paired: 1, DuplicateStarts: 1},
+		},
+		{
+			name:           "reordered",
+			events:         events("SSEE", 0, 12, 10, 20),
+			opts:           []PairingOptions{{Tolerance: 5 * time.Minute}},
+			excepted:       NewMany(minuteSpan(0, 10), minuteSpan(12, 20)),
+			exceptedReport: PairingReport{Paired: 2, Reordered: 1},
+		},
+		{
+			name:           "late",
+			events:         events("SSEE", 0, 12, 10, 20),
+			opts:           []PairingOptions{{Tolerance: time.Minute}},
+			excepted:       NewMany(minuteSpan(12, 20)),
+			exceptedReport: PairingReport{Paired: 1, UnmatchedStarts: 1, Late: 1},
+		},
+		{
+			name:           "unmatched_stop",
+			events:         events("ESE", 5, 10, 20),
+			excepted:       NewMany(minuteSpan(10, 20)),
+			exceptedReport: PairingReport{Paired: 1, UnmatchedStops: 1},
+		},
+		{
+			name:           "stop_before_start_same_time",
+			events:         events("SSEE", 0, 10, 10, 20),
+			excepted:       NewMany(minuteSpan(0, 10), minuteSpan(10, 20)),
+			exceptedReport: PairingReport{Paired: 2},
+		},
+		{
+			name:     "empty",
+			excepted: NewMany(),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result, report := PairEvents(tc.events, tc.opts...)
+			assert.Equal(t, tc.excepted, result)
+			assert.Equal(t, tc.exceptedReport, report)
+		})
+	}
+}
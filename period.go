@@ -0,0 +1,164 @@
+package timeinterval
+
+import (
+	"fmt"
+	"time"
+)
+
+// PeriodOptions first day of the week and first month of the fiscal year of calendar units.
+// The zero value is ISO weeks starting on Monday and years starting in January.
+type PeriodOptions struct {
+	// weekShift days from Monday to the first day of the week
+	weekShift int
+	// fiscalShift months from January to the first month of the fiscal year
+	fiscalShift int
+}
+
+// NewPeriodOptions initialization of calendar units with weeks starting on weekStart
+// and quarters and years starting in the month fiscalYearStart.
+// Out of range values wrap around: weekday 7 is Sunday, month 0 is December and month 13 is January,
+// use NewPeriodOptionsChecked to reject them.
+func NewPeriodOptions(weekStart time.Weekday, fiscalYearStart time.Month) PeriodOptions {
+	return PeriodOptions{
+		weekShift:   ((int(weekStart)+6)%7 + 7) % 7,
+		fiscalShift: ((int(fiscalYearStart)-1)%12 + 12) % 12,
+	}
+}
+
+// NewPeriodOptionsChecked initialization of calendar units as NewPeriodOptions with validation of the arguments.
+// Returns an error wrapping ErrInvalidWeekday or ErrInvalidMonth if an argument is out of range.
+func NewPeriodOptionsChecked(weekStart time.Weekday, fiscalYearStart time.Month) (PeriodOptions, error) {
+	if weekStart < time.Sunday || weekStart > time.Saturday {
+		return PeriodOptions{}, fmt.Errorf("%w: %d", ErrInvalidWeekday, weekStart)
+	}
+	if fiscalYearStart < time.January || fiscalYearStart > time.December {
+		return PeriodOptions{}, fmt.Errorf("%w: %d", ErrInvalidMonth, fiscalYearStart)
+	}
+	return NewPeriodOptions(weekStart, fiscalYearStart), nil
+}
+
+// WeekStart first day of the week.
+func (o PeriodOptions) WeekStart() time.Weekday {
+	return time.Weekday((o.weekShift + 1) % 7)
+}
+
+// FiscalYearStart first month of the fiscal year.
+func (o PeriodOptions) FiscalYearStart() time.Month {
+	return time.Month(o.fiscalShift + 1)
+}
+
+// DayOf time interval of the day containing t in the location.
+func DayOf(t time.Time, loc *time.Location) Span {
+	return PeriodOf(t, UnitDay, loc)
+}
+
+// ISOWeekOf time interval of the ISO week (starting on Monday) containing t in the location.
+func ISOWeekOf(t time.Time, loc *time.Location) Span {
+	return PeriodOf(t, UnitWeek, loc)
+}
+
+// WeekOf time interval of the week starting on weekStart containing t in the location.
+func WeekOf(t time.Time, loc *time.Location, weekStart time.Weekday) Span {
+	return PeriodOf(t, UnitWeek, loc, NewPeriodOptions(weekStart, time.January))
+}
+
+// MonthOf time interval of the month containing t in the location.
+func MonthOf(t time.Time, loc *time.Location) Span {
+	return PeriodOf(t, UnitMonth, loc)
+}
+
+// QuarterOf time interval of the calendar quarter containing t in the location.
+func QuarterOf(t time.Time, loc *time.Location) Span {
+	return PeriodOf(t, UnitQuarter, loc)
+}
+
+// YearOf time interval of the calendar year containing t in the location.
+func YearOf(t time.Time, loc *time.Location) Span {
+	return PeriodOf(t, UnitYear, loc)
+}
+
+// FiscalQuarterOf time interval of the quarter of the fiscal year starting in fiscalYearStart
+// containing t in the location. An out of range fiscalYearStart wraps around as in NewPeriodOptions.
+func FiscalQuarterOf(t time.Time, loc *time.Location, fiscalYearStart time.Month) Span {
+	return PeriodOf(t, UnitQuarter, loc, NewPeriodOptions(time.Monday, fiscalYearStart))
+}
+
+// FiscalYearOf time interval of the fiscal year starting in fiscalYearStart containing t in the location.
+// An out of range fiscalYearStart wraps around as in NewPeriodOptions.
+func FiscalYearOf(t time.Time, loc *time.Location, fiscalYearStart time.Month) Span {
+	return PeriodOf(t, UnitYear, loc, NewPeriodOptions(time.Monday, fiscalYearStart))
+}
+
+// PeriodOf time interval of the calendar unit containing t in the location, nil means UTC.
+// Periods start at midnight of the location, so on daylight saving time days they are shorter or longer.
+
+// opts - first day of the week and first month of the fiscal year, ISO weeks and calendar years by default.
+func PeriodOf(t time.Time, unit Unit, loc *time.Location, opts ...PeriodOptions) Span {
+	if loc == nil {
+		loc = time.UTC
+	}
+	start := periodOptions(opts).unitStart(t, unit, loc)
+	return Span{
+		start: start,
+		end:   unitAdd(start, unit, 1),
+	}
+}
+
+// NextPeriod time interval of the calendar unit following the period p (as returned by PeriodOf).
+func NextPeriod(p Span, unit Unit) Span {
+	return Span{
+		start: unitAdd(p.start, unit, 1),
+		end:   unitAdd(p.start, unit, 2),
+	}
+}
+
+// PrevPeriod time interval of the calendar unit preceding the period p (as returned by PeriodOf).
+func PrevPeriod(p Span, unit Unit) Span {
+	return Span{
+		start: unitAdd(p.start, unit, -1),
+		end:   p.start,
+	}
+}
+
+// Periods time intervals of all calendar units intersecting the window, in ascending order.
+// The first and the last period can go beyond the window.
+
+// opts - first day of the week and first month of the fiscal year, ISO weeks and calendar years by default.
+func Periods(window Span, unit Unit, loc *time.Location, opts ...PeriodOptions) SpanMany {
+	result := NewMany()
+	if !window.start.Before(window.end) {
+		return result
+	}
+	for p := PeriodOf(window.start, unit, loc, opts...); p.start.Before(window.end); p = NextPeriod(p, unit) {
+		result.spans = append(result.spans, p)
+	}
+	return result
+}
+
+// periodOptions optional PeriodOptions, the zero value by default.
+func periodOptions(opts []PeriodOptions) PeriodOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return PeriodOptions{}
+}
+
+// unitStart beginning of the calendar unit containing t in the location.
+func (o PeriodOptions) unitStart(t time.Time, u Unit, loc *time.Location) time.Time {
+	t = t.In(loc)
+	year, month, day := t.Date()
+	// months since the beginning of the fiscal year
+	fiscalMonth := (int(month) - 1 - o.fiscalShift + 12) % 12
+	switch u {
+	case UnitWeek:
+		return time.Date(year, month, day-(int(t.Weekday())+6-o.weekShift)%7, 0, 0, 0, 0, loc)
+	case UnitMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	case UnitQuarter:
+		return time.Date(year, month-time.Month(fiscalMonth%3), 1, 0, 0, 0, 0, loc)
+	case UnitYear:
+		return time.Date(year, month-time.Month(fiscalMonth), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	}
+}
@@ -0,0 +1,199 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// dateSpan time interval from midnight of the first date to midnight of the second one in UTC.
+func dateSpan(y1 int, m1 time.Month, d1 int, y2 int, m2 time.Month, d2 int) Span {
+	return Span{
+		start: time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC),
+		end:   time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestPeriodOf(t *testing.T) {
+	// Sunday
+	at := time.Date(2024, time.February, 18, 15, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		name   string
+		period func() Span
+		unit   Unit
+
+		excepted     Span
+		exceptedNext Span
+		exceptedPrev Span
+	}{
+		{
+			name:         "day",
+			period:       func() Span { return DayOf(at, time.UTC) },
+			unit:         UnitDay,
+			excepted:     dateSpan(2024, time.February, 18, 2024, time.February, 19),
+			exceptedNext: dateSpan(2024, time.February, 19, 2024, time.February, 20),
+			exceptedPrev: dateSpan(2024, time.February, 17, 2024, time.February, 18),
+		},
+		{
+			name:         "iso_week",
+			period:       func() Span { return ISOWeekOf(at, time.UTC) },
+			unit:         UnitWeek,
+			excepted:     dateSpan(2024, time.February, 12, 2024, time.February, 19),
+			exceptedNext: dateSpan(2024, time.February, 19, 2024, time.February, 26),
+			exceptedPrev: dateSpan(2024, time.February, 5, 2024, time.February, 12),
+		},
+		{
+			name:         "week_sunday",
+			period:       func() Span { return WeekOf(at, time.UTC, time.Sunday) },
+			unit:         UnitWeek,
+			excepted:     dateSpan(2024, time.February, 18, 2024, time.February, 25),
+			exceptedNext: dateSpan(2024, time.February, 25, 2024, time.March, 3),
+			exceptedPrev: dateSpan(2024, time.February, 11, 2024, time.February, 18),
+		},
+		{
+			name:         "week_saturday",
+			period:       func() Span { return WeekOf(at, time.UTC, time.Saturday) },
+			unit:         UnitWeek,
+			excepted:     dateSpan(2024, time.February, 17, 2024, time.February, 24),
+			exceptedNext: dateSpan(2024, time.February, 24, 2024, time.March, 2),
+			exceptedPrev: dateSpan(2024, time.February, 10, 2024, time.February, 17),
+		},
+		{
+			name:         "month",
+			period:       func() Span { return MonthOf(at, time.UTC) },
+			unit:         UnitMonth,
+			excepted:     dateSpan(2024, time.February, 1, 2024, time.March, 1),
+			exceptedNext: dateSpan(2024, time.March, 1, 2024, time.April, 1),
+			exceptedPrev: dateSpan(2024, time.January, 1, 2024, time.February, 1),
+		},
+		{
+			name:         "quarter",
+			period:       func() Span { return QuarterOf(at, time.UTC) },
+			unit:         UnitQuarter,
+			excepted:     dateSpan(2024, time.January, 1, 2024, time.April, 1),
+			exceptedNext: dateSpan(2024, time.April, 1, 2024, time.July, 1),
+			exceptedPrev: dateSpan(2023, time.October, 1, 2024, time.January, 1),
+		},
+		{
+			name:         "year",
+			period:       func() Span { return YearOf(at, time.UTC) },
+			unit:         UnitYear,
+			excepted:     dateSpan(2024, time.January, 1, 2025, time.January, 1),
+			exceptedNext: dateSpan(2025, time.January, 1, 2026, time.January, 1),
+			exceptedPrev: dateSpan(2023, time.January, 1, 2024, time.January, 1),
+		},
+		{
+			name:         "fiscal_quarter",
+			period:       func() Span { return FiscalQuarterOf(at, time.UTC, time.April) },
+			unit:         UnitQuarter,
+			excepted:     dateSpan(2024, time.January, 1, 2024, time.April, 1),
+			exceptedNext: dateSpan(2024, time.April, 1, 2024, time.July, 1),
+			exceptedPrev: dateSpan(2023, time.October, 1, 2024, time.January, 1),
+		},
+		{
+			name:         "fiscal_quarter_february",
+			period:       func() Span { return FiscalQuarterOf(at, time.UTC, time.February) },
+			unit:         UnitQuarter,
+			excepted:     dateSpan(2024, time.February, 1, 2024, time.May, 1),
+			exceptedNext: dateSpan(2024, time.May, 1, 2024, time.August, 1),
+			exceptedPrev: dateSpan(2023, time.November, 1, 2024, time.February, 1),
+		},
+		{
+			name:         "fiscal_year",
+			period:       func() Span { return FiscalYearOf(at, time.UTC, time.October) },
+			unit:         UnitYear,
+			excepted:     dateSpan(2023, time.October, 1, 2024, time.October, 1),
+			exceptedNext: dateSpan(2024, time.October, 1, 2025, time.October, 1),
+			exceptedPrev: dateSpan(2022, time.October, 1, 2023, time.October, 1),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.period()
+			assert.Equal(t, tc.excepted, result)
+			assert.Equal(t, tc.exceptedNext, NextPeriod(result, tc.unit))
+			assert.Equal(t, tc.exceptedPrev, PrevPeriod(result, tc.unit))
+		})
+	}
+}
+
+func TestPeriodOfLocation(t *testing.T) {
+	newYork := loadLocation(t, "America/New_York")
+	// Monday 03:00 UTC is still Sunday in New York
+	at := time.Date(2024, time.March, 11, 3, 0, 0, 0, time.UTC)
+
+	day := DayOf(at, newYork)
+	assert.Equal(t, time.Date(2024, time.March, 10, 0, 0, 0, 0, newYork), day.Start())
+	assert.Equal(t, 23*time.Hour, day.Duration())
+	week := ISOWeekOf(at, newYork)
+	assert.Equal(t, time.Date(2024, time.March, 4, 0, 0, 0, 0, newYork), week.Start())
+	assert.Equal(t, dateSpan(2024, time.March, 11, 2024, time.March, 12), DayOf(at, nil))
+}
+
+func TestPeriods(t *testing.T) {
+	window := Span{
+		start: time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC),
+		end:   time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+	}
+	assert.Equal(t, NewMany(
+		dateSpan(2024, time.January, 1, 2024, time.February, 1),
+		dateSpan(2024, time.February, 1, 2024, time.March, 1),
+		dateSpan(2024, time.March, 1, 2024, time.April, 1),
+	), Periods(window, UnitMonth, time.UTC))
+
+	opts := NewPeriodOptions(time.Sunday, time.July)
+	assert.Equal(t, time.Sunday, opts.WeekStart())
+	assert.Equal(t, time.July, opts.FiscalYearStart())
+	assert.Equal(t, NewMany(dateSpan(2023, time.July, 1, 2024, time.July, 1)), Periods(window, UnitYear, time.UTC, opts))
+	assert.Equal(t, NewMany(
+		dateSpan(2023, time.October, 1, 2024, time.January, 1),
+		dateSpan(2024, time.January, 1, 2024, time.April, 1),
+	), Periods(Span{start: window.start.AddDate(0, 0, -15), end: window.end}, UnitQuarter, time.UTC, opts))
+	weeks := Periods(window, UnitWeek, time.UTC, opts)
+	assert.Len(t, weeks.Spans(), 12)
+	assert.Equal(t, dateSpan(2024, time.January, 14, 2024, time.January, 21), weeks.Spans()[0])
+	assert.Equal(t, NewMany(), Periods(Span{}, UnitDay, time.UTC))
+
+	var iso PeriodOptions
+	assert.Equal(t, time.Monday, iso.WeekStart())
+	assert.Equal(t, time.January, iso.FiscalYearStart())
+}
+
+func TestNewPeriodOptions(t *testing.T) {
+	testCases := []struct {
+		name            string
+		weekStart       time.Weekday
+		fiscalYearStart time.Month
+		excepted        PeriodOptions
+		err             error
+	}{
+		{name: "valid", weekStart: time.Sunday, fiscalYearStart: time.July, excepted: NewPeriodOptions(time.Sunday, time.July)},
+		{name: "month_zero", weekStart: time.Monday, fiscalYearStart: 0, err: ErrInvalidMonth},
+		{name: "month_13", weekStart: time.Monday, fiscalYearStart: 13, err: ErrInvalidMonth},
+		{name: "weekday_7", weekStart: 7, fiscalYearStart: time.January, err: ErrInvalidWeekday},
+		{name: "weekday_negative", weekStart: -1, fiscalYearStart: time.January, err: ErrInvalidWeekday},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			opts, err := NewPeriodOptionsChecked(tc.weekStart, tc.fiscalYearStart)
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.excepted, opts)
+		})
+	}
+
+	// the unchecked constructor wraps out of range values around
+	wrapped := NewPeriodOptions(7, 0)
+	assert.Equal(t, time.Sunday, wrapped.WeekStart())
+	assert.Equal(t, time.December, wrapped.FiscalYearStart())
+	wrapped = NewPeriodOptions(-1, 13)
+	assert.Equal(t, time.Saturday, wrapped.WeekStart())
+	assert.Equal(t, time.January, wrapped.FiscalYearStart())
+}
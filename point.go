@@ -0,0 +1,78 @@
+package timeinterval
+
+import (
+	"sort"
+	"time"
+)
+
+// Contains checking that t is inside one of the time intervals (start inclusive, end exclusive).
+// Point-in-time queries use binary search, so SpanMany must be sorted and merged (as returned by Union),
+// otherwise the results are undefined.
+func (s *SpanMany) Contains(t time.Time) bool {
+	_, ok := s.At(t)
+	return ok
+}
+
+// At time interval containing t. SpanMany must be sorted and merged.
+func (s *SpanMany) At(t time.Time) (Span, bool) {
+	i := s.endAfter(t)
+	if i < len(s.spans) && beforeOrEqual(s.spans[i].start, t) {
+		return s.spans[i], true
+	}
+	return Span{}, false
+}
+
+// NextStart start of the first time interval starting after t, the end of the current gap.
+// SpanMany must be sorted and merged.
+func (s *SpanMany) NextStart(t time.Time) (time.Time, bool) {
+	i := sort.Search(len(s.spans), func(i int) bool {
+		return s.spans[i].start.After(t)
+	})
+	if i == len(s.spans) {
+		return time.Time{}, false
+	}
+	return s.spans[i].start, true
+}
+
+// NextEnd end of the first time interval ending after t: the end of the time interval containing t
+// or of the next one. SpanMany must be sorted and merged.
+func (s *SpanMany) NextEnd(t time.Time) (time.Time, bool) {
+	i := s.endAfter(t)
+	if i == len(s.spans) {
+		return time.Time{}, false
+	}
+	return s.spans[i].end, true
+}
+
+// PrevEnd end of the last time interval ending not after t, the start of the current gap.
+// SpanMany must be sorted and merged.
+func (s *SpanMany) PrevEnd(t time.Time) (time.Time, bool) {
+	i := s.endAfter(t)
+	if i == 0 {
+		return time.Time{}, false
+	}
+	return s.spans[i-1].end, true
+}
+
+// NextFree earliest time not before t starting a gap between time intervals at least length long.
+// The time after the last time interval is free, so such a time always exists.
+// SpanMany must be sorted and merged.
+func (s *SpanMany) NextFree(t time.Time, length time.Duration) time.Time {
+	free := t
+	for i := s.endAfter(t); i < len(s.spans); i++ {
+		if s.spans[i].start.Sub(free) >= length {
+			break
+		}
+		if s.spans[i].end.After(free) {
+			free = s.spans[i].end
+		}
+	}
+	return free
+}
+
+// endAfter index of the first time interval ending after t.
+func (s *SpanMany) endAfter(t time.Time) int {
+	return sort.Search(len(s.spans), func(i int) bool {
+		return s.spans[i].end.After(t)
+	})
+}
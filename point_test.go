@@ -0,0 +1,126 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPointQueries(t *testing.T) {
+	many := NewMany(minuteSpan(10, 20), minuteSpan(30, 40), minuteSpan(45, 60))
+	testCases := []struct {
+		name string
+		t    int
+
+		exceptedAt        Span
+		exceptedNextStart int
+		exceptedNextEnd   int
+		exceptedPrevEnd   int
+	}{
+		{
+			name:              "before_all",
+			t:                 0,
+			exceptedNextStart: 10,
+			exceptedNextEnd:   20,
+			exceptedPrevEnd:   -1,
+		},
+		{
+			name:              "at_start",
+			t:                 10,
+			exceptedAt:        minuteSpan(10, 20),
+			exceptedNextStart: 30,
+			exceptedNextEnd:   20,
+			exceptedPrevEnd:   -1,
+		},
+		{
+			name:              "at_end",
+			t:                 20,
+			exceptedNextStart: 30,
+			exceptedNextEnd:   40,
+			exceptedPrevEnd:   20,
+		},
+		{
+			name:              "inside",
+			t:                 35,
+			exceptedAt:        minuteSpan(30, 40),
+			exceptedNextStart: 45,
+			exceptedNextEnd:   40,
+			exceptedPrevEnd:   20,
+		},
+		{
+			name:              "inside_last",
+			t:                 50,
+			exceptedAt:        minuteSpan(45, 60),
+			exceptedNextStart: -1,
+			exceptedNextEnd:   60,
+			exceptedPrevEnd:   40,
+		},
+		{
+			name:              "after_all",
+			t:                 70,
+			exceptedNextStart: -1,
+			exceptedNextEnd:   -1,
+			exceptedPrevEnd:   60,
+		},
+	}
+	// minuteOrNone expected time and presence, -1 means no time
+	minuteOrNone := func(m int) (time.Time, bool) {
+		if m < 0 {
+			return time.Time{}, false
+		}
+		return minutes(m)[0], true
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			at := minutes(tc.t)[0]
+			span, ok := many.At(at)
+			assert.Equal(t, tc.exceptedAt, span)
+			assert.Equal(t, !tc.exceptedAt.IsEmpty(), ok)
+			assert.Equal(t, ok, many.Contains(at))
+
+			excepted, exceptedOk := minuteOrNone(tc.exceptedNextStart)
+			result, ok := many.NextStart(at)
+			assert.Equal(t, excepted, result)
+			assert.Equal(t, exceptedOk, ok)
+
+			excepted, exceptedOk = minuteOrNone(tc.exceptedNextEnd)
+			result, ok = many.NextEnd(at)
+			assert.Equal(t, excepted, result)
+			assert.Equal(t, exceptedOk, ok)
+
+			excepted, exceptedOk = minuteOrNone(tc.exceptedPrevEnd)
+			result, ok = many.PrevEnd(at)
+			assert.Equal(t, excepted, result)
+			assert.Equal(t, exceptedOk, ok)
+		})
+	}
+}
+
+func TestNextFree(t *testing.T) {
+	many := NewMany(minuteSpan(10, 20), minuteSpan(30, 40), minuteSpan(45, 60))
+	testCases := []struct {
+		name   string
+		t      int
+		length time.Duration
+
+		excepted int
+	}{
+		{name: "free_now", t: 0, length: 10 * time.Minute, excepted: 0},
+		{name: "gap_too_short", t: 0, length: 11 * time.Minute, excepted: 60},
+		{name: "inside", t: 15, length: 10 * time.Minute, excepted: 20},
+		{name: "skip_short_gap", t: 35, length: 10 * time.Minute, excepted: 60},
+		{name: "fits_short_gap", t: 35, length: 5 * time.Minute, excepted: 40},
+		{name: "after_all", t: 70, length: time.Hour, excepted: 70},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, minutes(tc.excepted)[0], many.NextFree(minutes(tc.t)[0], tc.length))
+		})
+	}
+	empty := NewMany()
+	assert.False(t, empty.Contains(minutes(0)[0]))
+	assert.Equal(t, minutes(5)[0], empty.NextFree(minutes(5)[0], time.Hour))
+}
@@ -0,0 +1,32 @@
+package timeinterval
+
+import "time"
+
+// Quorum time covered by at least k of the input SpanMany.
+// Each input is sorted and merged first, so overlaps inside one set are counted once.
+// k = 1 is the union of all sets, k = len(sets) is their intersection.
+// k less than 1 is treated as 1, k greater than the number of sets returns an empty SpanMany.
+func Quorum(k int, sets ...SpanMany) SpanMany {
+	if k > len(sets) {
+		return NewMany()
+	}
+	all := NewMany()
+	for _, set := range sets {
+		n := set.normalized()
+		all.AddMany(n.spans...)
+	}
+	return all.SpansWithDepthAtLeast(k)
+}
+
+// QuorumSlots candidate start times of the slots with the length,
+// when at least k of the input SpanMany are available.
+// Slots follow each other back to back from the beginning of every suitable time interval,
+// use FindSlots with Quorum for alignment, buffers and other options.
+func QuorumSlots(k int, length time.Duration, sets ...SpanMany) []time.Time {
+	var result []time.Time
+	slots := FindSlots(Quorum(k, sets...), length)
+	for _, sp := range slots.spans {
+		result = append(result, sp.start)
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuorum(t *testing.T) {
+	alice := NewMany(
+		Span{
+			time.Date(2020, 10, 19, 9, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 12, 0, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 11, 0, 0, 0, time.UTC)},
+	)
+	bob := NewMany(
+		Span{
+			time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 14, 0, 0, 0, time.UTC)},
+	)
+	carol := NewMany(
+		Span{
+			time.Date(2020, 10, 19, 11, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 13, 0, 0, 0, time.UTC)},
+	)
+	testCases := []struct {
+		name string
+		k    int
+		sets []SpanMany
+
+		excepted SpanMany
+	}{
+		{
+			name: "union",
+			k:    1,
+			sets: []SpanMany{alice, bob, carol},
+			excepted: NewMany(
+				Span{
+					time.Date(2020, 10, 19, 9, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 19, 14, 0, 0, 0, time.UTC)},
+			),
+		},
+		{
+			name: "two_of_three",
+			k:    2,
+			sets: []SpanMany{alice, bob, carol},
+			excepted: NewMany(
+				Span{
+					time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 19, 13, 0, 0, 0, time.UTC)},
+			),
+		},
+		{
+			name: "intersection",
+			k:    3,
+			sets: []SpanMany{alice, bob, carol},
+			excepted: NewMany(
+				Span{
+					time.Date(2020, 10, 19, 11, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 19, 12, 0, 0, 0, time.UTC)},
+			),
+		},
+		{
+			name:     "k_more_sets",
+			k:        4,
+			sets:     []SpanMany{alice, bob, carol},
+			excepted: NewMany(),
+		},
+		{
+			name:     "no_sets",
+			k:        1,
+			excepted: NewMany(),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := Quorum(tc.k, tc.sets...)
+			assert.Equal(t, tc.excepted, result)
+		})
+	}
+	// inputs are not modified
+	assert.Len(t, alice.Spans(), 2)
+}
+
+func TestQuorumSlots(t *testing.T) {
+	alice := NewMany(
+		Span{
+			time.Date(2020, 10, 19, 9, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 12, 0, 0, 0, time.UTC)},
+	)
+	bob := NewMany(
+		Span{
+			time.Date(2020, 10, 19, 10, 45, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 14, 0, 0, 0, time.UTC)},
+	)
+	result := QuorumSlots(2, 30*time.Minute, alice, bob)
+	assert.Equal(t, []time.Time{
+		time.Date(2020, 10, 19, 10, 45, 0, 0, time.UTC),
+		time.Date(2020, 10, 19, 11, 15, 0, 0, time.UTC),
+	}, result)
+	assert.Empty(t, QuorumSlots(2, 2*time.Hour, alice, bob))
+	assert.Empty(t, QuorumSlots(1, 0, alice, bob))
+}
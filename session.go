@@ -0,0 +1,116 @@
+package timeinterval
+
+import (
+	"sort"
+	"time"
+)
+
+// SessionOptions settings for building activity sessions from timestamps.
+type SessionOptions struct {
+	// MinLength sessions shorter than MinLength (with padding) are dropped.
+	MinLength time.Duration
+	// Padding time added after the last timestamp of a session.
+	// Without padding a session of a single timestamp has zero length and is dropped.
+	Padding time.Duration
+}
+
+// Sessionize activity sessions from timestamps: a new session starts after the inactivity
+// longer than gap. Timestamps can be in any order. A session lasts from its first timestamp
+// to the last one plus padding, the result is sorted and merged.
+func Sessionize(times []time.Time, gap time.Duration, opts ...SessionOptions) SpanMany {
+	sorted := append([]time.Time(nil), times...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Before(sorted[j])
+	})
+	s := NewSessionizer(gap, opts...)
+	result := NewMany()
+	for _, t := range sorted {
+		if session, ok := s.Push(t); ok {
+			result.AddMany(session)
+		}
+	}
+	if session, ok := s.Flush(); ok {
+		result.AddMany(session)
+	}
+	return result.Union()
+}
+
+// Sessionizer streaming variant of Sessionize: accepts timestamps one by one
+// and emits sessions as soon as they are closed.
+type Sessionizer struct {
+	gap   time.Duration
+	opt   SessionOptions
+	open  bool
+	start time.Time
+	last  time.Time
+	late  int
+}
+
+// NewSessionizer initialization of a new Sessionizer.
+func NewSessionizer(gap time.Duration, opts ...SessionOptions) *Sessionizer {
+	s := &Sessionizer{gap: gap}
+	if len(opts) > 0 {
+		s.opt = opts[0]
+	}
+	return s
+}
+
+// Push adding a timestamp. If it comes after the inactivity longer than gap,
+// the previous session is closed and returned. Timestamps earlier than the last one
+// are merged into the open session if they are no more than gap before its start,
+// older ones are dropped and counted by Late.
+func (s *Sessionizer) Push(t time.Time) (Span, bool) {
+	if !s.open {
+		s.open, s.start, s.last = true, t, t
+		return Span{}, false
+	}
+	if t.Sub(s.last) > s.gap {
+		session, ok := s.close()
+		s.open, s.start, s.last = true, t, t
+		return session, ok
+	}
+	if s.start.Sub(t) > s.gap {
+		s.late++
+		return Span{}, false
+	}
+	if t.Before(s.start) {
+		s.start = t
+	}
+	if t.After(s.last) {
+		s.last = t
+	}
+	return Span{}, false
+}
+
+// Late number of timestamps dropped by Push for being more than gap before the open session.
+func (s *Sessionizer) Late() int {
+	return s.late
+}
+
+// Advance closing the open session if there were no timestamps for longer than gap before now.
+func (s *Sessionizer) Advance(now time.Time) (Span, bool) {
+	if !s.open || now.Sub(s.last) <= s.gap {
+		return Span{}, false
+	}
+	return s.close()
+}
+
+// Flush closing the open session regardless of the inactivity, for example at the end of the stream.
+func (s *Sessionizer) Flush() (Span, bool) {
+	if !s.open {
+		return Span{}, false
+	}
+	return s.close()
+}
+
+func (s *Sessionizer) close() (Span, bool) {
+	s.open = false
+	session := Span{
+		start: s.start,
+		end:   s.last.Add(s.opt.Padding),
+	}
+	if !session.start.Before(session.end) || session.Duration() < s.opt.MinLength {
+		return Span{}, false
+	}
+	return session, true
+}
@@ -0,0 +1,131 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func minutes(m ...int) []time.Time {
+	result := make([]time.Time, 0, len(m))
+	for _, v := range m {
+		result = append(result, time.Date(2020, 10, 19, 9, 0, 0, 0, time.UTC).Add(time.Duration(v)*time.Minute))
+	}
+	return result
+}
+
+func minuteSpan(from, to int) Span {
+	t := minutes(from, to)
+	return Span{t[0], t[1]}
+}
+
+func TestSessionize(t *testing.T) {
+	testCases := []struct {
+		name  string
+		times []time.Time
+		gap   time.Duration
+		opts  []SessionOptions
+
+		excepted SpanMany
+	}{
+		{
+			name:     "sessions",
+			times:    minutes(0, 2, 5, 30, 31, 60),
+			gap:      10 * time.Minute,
+			excepted: NewMany(minuteSpan(0, 5), minuteSpan(30, 31)),
+		},
+		{
+			name:     "unordered",
+			times:    minutes(31, 5, 0, 30, 2),
+			gap:      10 * time.Minute,
+			excepted: NewMany(minuteSpan(0, 5), minuteSpan(30, 31)),
+		},
+		{
+			name:     "padding",
+			times:    minutes(0, 2, 5, 30, 31, 60),
+			gap:      10 * time.Minute,
+			opts:     []SessionOptions{{Padding: time.Minute}},
+			excepted: NewMany(minuteSpan(0, 6), minuteSpan(30, 32), minuteSpan(60, 61)),
+		},
+		{
+			name:     "min_length",
+			times:    minutes(0, 2, 5, 30, 31, 60),
+			gap:      10 * time.Minute,
+			opts:     []SessionOptions{{Padding: time.Minute, MinLength: 3 * time.Minute}},
+			excepted: NewMany(minuteSpan(0, 6)),
+		},
+		{
+			name:     "padding_longer_gap",
+			times:    minutes(0, 5, 20),
+			gap:      10 * time.Minute,
+			opts:     []SessionOptions{{Padding: 15 * time.Minute}},
+			excepted: NewMany(minuteSpan(0, 35)),
+		},
+		{
+			name:     "empty",
+			gap:      10 * time.Minute,
+			excepted: NewMany(),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := Sessionize(tc.times, tc.gap, tc.opts...)
+			assert.Equal(t, tc.excepted, result)
+		})
+	}
+}
+
+func TestSessionizer(t *testing.T) {
+	s := NewSessionizer(10*time.Minute, SessionOptions{Padding: time.Minute})
+	times := minutes(0, 3, 1, 20, 21, 40)
+
+	_, ok := s.Push(times[0])
+	assert.False(t, ok)
+	_, ok = s.Push(times[1])
+	assert.False(t, ok)
+	_, ok = s.Advance(minutes(13)[0])
+	assert.False(t, ok)
+	_, ok = s.Push(times[2])
+	assert.False(t, ok)
+
+	session, ok := s.Push(times[3])
+	assert.True(t, ok)
+	assert.Equal(t, minuteSpan(0, 4), session)
+
+	_, ok = s.Push(times[4])
+	assert.False(t, ok)
+	session, ok = s.Advance(minutes(32)[0])
+	assert.True(t, ok)
+	assert.Equal(t, minuteSpan(20, 22), session)
+	_, ok = s.Advance(minutes(50)[0])
+	assert.False(t, ok)
+
+	_, ok = s.Push(times[5])
+	assert.False(t, ok)
+	session, ok = s.Flush()
+	assert.True(t, ok)
+	assert.Equal(t, minuteSpan(40, 41), session)
+	_, ok = s.Flush()
+	assert.False(t, ok)
+}
+
+func TestSessionizerLate(t *testing.T) {
+	s := NewSessionizer(10*time.Minute, SessionOptions{Padding: time.Minute})
+	for _, tm := range minutes(300, 305) {
+		_, ok := s.Push(tm)
+		assert.False(t, ok)
+	}
+	// within gap before the start of the session
+	_, ok := s.Push(minutes(292)[0])
+	assert.False(t, ok)
+	// hours before the session
+	_, ok = s.Push(minutes(0)[0])
+	assert.False(t, ok)
+	assert.Equal(t, 1, s.Late())
+
+	session, ok := s.Flush()
+	assert.True(t, ok)
+	assert.Equal(t, minuteSpan(292, 306), session)
+}
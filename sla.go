@@ -0,0 +1,74 @@
+package timeinterval
+
+import "time"
+
+// SLAClock stop-the-clock SLA timer: the time of the ticket is counted except pauses
+// (for example waiting for the customer) and, if set, outside of business hours.
+type SLAClock struct {
+	ticket Span
+	budget time.Duration
+	// counted sorted and merged time intervals of the ticket counted against the budget.
+	counted SpanMany
+}
+
+// NewSLAClock initialization of a new SLAClock for the ticket with the time budget.
+// Pauses may overlap each other and extend past the ticket.
+
+// businessHours - materialized business hours, if set only the time within them is counted.
+func NewSLAClock(ticket Span, budget time.Duration, pauses SpanMany, businessHours ...SpanMany) SLAClock {
+	counted := NewMany()
+	if ticket.start.Before(ticket.end) {
+		counted = NewMany(ticket)
+		counted = counted.exceptMany(pauses)
+	}
+	if len(businessHours) > 0 {
+		counted = Quorum(2, counted, Quorum(1, businessHours...))
+	}
+	return SLAClock{
+		ticket:  ticket,
+		budget:  budget,
+		counted: counted,
+	}
+}
+
+// Counted time intervals of the ticket counted against the budget.
+func (c *SLAClock) Counted() SpanMany {
+	return NewMany(append([]Span(nil), c.counted.spans...)...)
+}
+
+// Elapsed counted time of the ticket.
+
+// at - moment of the measurement (default the end of the ticket).
+func (c *SLAClock) Elapsed(at ...time.Time) time.Duration {
+	if len(at) == 0 {
+		return c.counted.Duration()
+	}
+	if !c.ticket.start.Before(at[0]) {
+		return 0
+	}
+	elapsed := c.counted.Intersection(Span{start: c.ticket.start, end: at[0]})
+	return elapsed.Duration()
+}
+
+// Remaining budget left, negative if the SLA is breached.
+
+// at - moment of the measurement (default the end of the ticket).
+func (c *SLAClock) Remaining(at ...time.Time) time.Duration {
+	return c.budget - c.Elapsed(at...)
+}
+
+// BreachAt the instant when the counted time reaches the budget.
+// Returns false if the budget is not exhausted within the ticket.
+func (c *SLAClock) BreachAt() (time.Time, bool) {
+	if c.budget <= 0 {
+		return c.ticket.start, c.ticket.start.Before(c.ticket.end)
+	}
+	left := c.budget
+	for _, sp := range c.counted.spans {
+		if left <= sp.Duration() {
+			return sp.start.Add(left), true
+		}
+		left -= sp.Duration()
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,89 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSLAClock(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2020, 10, 19, hour, 0, 0, 0, time.UTC)
+	}
+	ticket := hours(9, 17)
+	pauses := NewMany(hours(10, 11), Span{at(10).Add(30 * time.Minute), at(12)}, hours(16, 18))
+
+	testCases := []struct {
+		name          string
+		budget        time.Duration
+		businessHours []SpanMany
+
+		exceptedCounted   SpanMany
+		exceptedElapsed   time.Duration
+		exceptedRemaining time.Duration
+		exceptedBreached  bool
+		exceptedBreach    time.Time
+	}{
+		{
+			name:              "pauses",
+			budget:            4 * time.Hour,
+			exceptedCounted:   NewMany(hours(9, 10), hours(12, 16)),
+			exceptedElapsed:   5 * time.Hour,
+			exceptedRemaining: -time.Hour,
+			exceptedBreached:  true,
+			exceptedBreach:    at(15),
+		},
+		{
+			name:              "business_hours",
+			budget:            4 * time.Hour,
+			businessHours:     []SpanMany{NewMany(hours(9, 13), hours(14, 17))},
+			exceptedCounted:   NewMany(hours(9, 10), hours(12, 13), hours(14, 16)),
+			exceptedElapsed:   4 * time.Hour,
+			exceptedRemaining: 0,
+			exceptedBreached:  true,
+			exceptedBreach:    at(16),
+		},
+		{
+			name:              "not_breached",
+			budget:            10 * time.Hour,
+			exceptedCounted:   NewMany(hours(9, 10), hours(12, 16)),
+			exceptedElapsed:   5 * time.Hour,
+			exceptedRemaining: 5 * time.Hour,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewSLAClock(ticket, tc.budget, pauses, tc.businessHours...)
+			assert.Equal(t, tc.exceptedCounted, c.Counted())
+			assert.Equal(t, tc.exceptedElapsed, c.Elapsed())
+			assert.Equal(t, tc.exceptedRemaining, c.Remaining())
+			breach, ok := c.BreachAt()
+			assert.Equal(t, tc.exceptedBreached, ok)
+			assert.Equal(t, tc.exceptedBreach, breach)
+		})
+	}
+}
+
+func TestSLAClockElapsedAt(t *testing.T) {
+	pauses := NewMany(hours(10, 12))
+	c := NewSLAClock(hours(9, 17), 4*time.Hour, pauses)
+	testCases := []struct {
+		name     string
+		at       time.Time
+		excepted time.Duration
+	}{
+		{name: "before_ticket", at: time.Date(2020, 10, 19, 8, 0, 0, 0, time.UTC), excepted: 0},
+		{name: "in_pause", at: time.Date(2020, 10, 19, 11, 0, 0, 0, time.UTC), excepted: time.Hour},
+		{name: "after_pause", at: time.Date(2020, 10, 19, 13, 0, 0, 0, time.UTC), excepted: 2 * time.Hour},
+		{name: "after_ticket", at: time.Date(2020, 10, 19, 20, 0, 0, 0, time.UTC), excepted: 6 * time.Hour},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.excepted, c.Elapsed(tc.at))
+			assert.Equal(t, 4*time.Hour-tc.excepted, c.Remaining(tc.at))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package timeinterval
+
+import "time"
+
+// SlotOptions settings for searching free slots.
+type SlotOptions struct {
+	// Align the wall clock of the start of a slot is a multiple of Align
+	// in the location of the free time (for example 15 minutes gives :00/:15/:30/:45).
+	// Zero value disables alignment.
+	Align time.Duration
+	// Step distance between the starts of the candidate slots.
+	// By default Align is used, and the length of the slot if there is no alignment.
+	// With alignment Step is rounded up to a multiple of Align.
+	Step time.Duration
+	// BufferBefore free time required before the slot.
+	BufferBefore time.Duration
+	// BufferAfter free time required after the slot.
+	BufferAfter time.Duration
+	// Limit maximum number of slots, zero value means no limit.
+	Limit int
+	// Order Ascending returns the earliest slots first (default), Descending - the same slots latest first.
+	Order SortType
+}
+
+// FindSlots every bookable slot of the length inside the free time.
+// The free time is sorted and merged before the search, the input is not modified.
+// Buffers are not included in the returned slots, but must fit into the same free interval.
+func FindSlots(free SpanMany, length time.Duration, opts ...SlotOptions) SpanMany {
+	var opt SlotOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	result := NewMany()
+	if length <= 0 {
+		return result
+	}
+	step := opt.Step
+	if step <= 0 {
+		step = opt.Align
+	}
+	if step <= 0 {
+		step = length
+	}
+
+	if opt.Align > 0 && step%opt.Align != 0 {
+		// aligned starts are a multiple of Align apart
+		step += opt.Align - step%opt.Align
+	}
+
+	spans := free.normalized().spans
+	if opt.Order == Descending {
+		for i := len(spans) - 1; i >= 0 && !slotsFull(result.spans, opt); i-- {
+			result.spans = appendSlots(result.spans, spans[i], length, step, opt)
+		}
+		return result
+	}
+	for _, sp := range spans {
+		if slotsFull(result.spans, opt) {
+			break
+		}
+		result.spans = appendSlots(result.spans, sp, length, step, opt)
+	}
+	return result
+}
+
+// appendSlots appending slots of the length inside one free time interval in the order of opt until Limit is reached.
+// The k-th slot of the interval starts at alignUp(first + k*step), first is the earliest aligned start,
+// so the slots are generated one at a time from either end.
+func appendSlots(slots []Span, free Span, length, step time.Duration, opt SlotOptions) []Span {
+	first := alignUp(free.start.Add(opt.BufferBefore), opt.Align)
+	latest := free.end.Add(-opt.BufferAfter - length)
+	if first.After(latest) {
+		return slots
+	}
+	at := func(k int64) time.Time {
+		return alignUp(first.Add(time.Duration(k)*step), opt.Align)
+	}
+	if opt.Order == Descending {
+		k := int64(latest.Sub(first) / step)
+		for k >= 0 && at(k).After(latest) {
+			k--
+		}
+		for ; k >= 0 && !slotsFull(slots, opt); k-- {
+			start := at(k)
+			slots = append(slots, Span{start: start, end: start.Add(length)})
+		}
+		return slots
+	}
+	for k := int64(0); !slotsFull(slots, opt); k++ {
+		start := at(k)
+		if start.After(latest) {
+			break
+		}
+		slots = append(slots, Span{start: start, end: start.Add(length)})
+	}
+	return slots
+}
+
+// slotsFull Limit of the slots is reached.
+func slotsFull(slots []Span, opt SlotOptions) bool {
+	return opt.Limit > 0 && len(slots) >= opt.Limit
+}
+
+// alignUp the nearest time at or after t whose wall clock is a multiple of align,
+// so on daylight saving time days slots stay on the grid of the wall clock.
+func alignUp(t time.Time, align time.Duration) time.Time {
+	if align <= 0 {
+		return t
+	}
+	clock := clockOf(t)
+	rem := clock % align
+	if rem == 0 {
+		return t
+	}
+	year, month, day := t.Date()
+	aligned := atClock(year, month, day, clock-rem+align, t.Location())
+	if !aligned.After(t) {
+		// the wall clock is repeated when clocks go back, take the elapsed time instead
+		return t.Add(align - rem)
+	}
+	return aligned
+}
@@ -0,0 +1,182 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindSlots(t *testing.T) {
+	free := NewMany(
+		Span{
+			time.Date(2020, 10, 19, 14, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 14, 40, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 10, 19, 9, 7, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 11, 0, 0, 0, time.UTC)},
+	)
+	slot := func(hour, min int) Span {
+		start := time.Date(2020, 10, 19, hour, min, 0, 0, time.UTC)
+		return Span{start, start.Add(30 * time.Minute)}
+	}
+	testCases := []struct {
+		name   string
+		length time.Duration
+		opts   []SlotOptions
+
+		excepted SpanMany
+	}{
+		{
+			name:     "back_to_back",
+			length:   30 * time.Minute,
+			excepted: NewMany(slot(9, 7), slot(9, 37), slot(10, 7), slot(14, 0)),
+		},
+		{
+			name:     "align",
+			length:   30 * time.Minute,
+			opts:     []SlotOptions{{Align: 15 * time.Minute}},
+			excepted: NewMany(slot(9, 15), slot(9, 30), slot(9, 45), slot(10, 0), slot(10, 15), slot(10, 30), slot(14, 0)),
+		},
+		{
+			name:     "align_step",
+			length:   30 * time.Minute,
+			opts:     []SlotOptions{{Align: 15 * time.Minute, Step: 30 * time.Minute}},
+			excepted: NewMany(slot(9, 15), slot(9, 45), slot(10, 15), slot(14, 0)),
+		},
+		{
+			name:   "buffers",
+			length: 30 * time.Minute,
+			opts: []SlotOptions{{
+				Align:        15 * time.Minute,
+				BufferBefore: 10 * time.Minute,
+				BufferAfter:  10 * time.Minute,
+			}},
+			excepted: NewMany(slot(9, 30), slot(9, 45), slot(10, 0), slot(10, 15)),
+		},
+		{
+			name:     "descending_limit",
+			length:   30 * time.Minute,
+			opts:     []SlotOptions{{Align: 15 * time.Minute, Order: Descending, Limit: 3}},
+			excepted: NewMany(slot(14, 0), slot(10, 30), slot(10, 15)),
+		},
+		{
+			name:     "descending",
+			length:   30 * time.Minute,
+			opts:     []SlotOptions{{Order: Descending}},
+			excepted: NewMany(slot(14, 0), slot(10, 7), slot(9, 37), slot(9, 7)),
+		},
+		{
+			name:     "too_long",
+			length:   3 * time.Hour,
+			excepted: NewMany(),
+		},
+		{
+			name:     "zero_length",
+			length:   0,
+			excepted: NewMany(),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := FindSlots(free, tc.length, tc.opts...)
+			assert.Equal(t, tc.excepted, result)
+		})
+	}
+}
+
+func TestFindSlotsDST(t *testing.T) {
+	newYork := loadLocation(t, "America/New_York")
+	testCases := []struct {
+		name string
+		free Span
+
+		excepted []time.Time
+	}{
+		{
+			// clocks go back from 02:00 EDT to 01:00 EST
+			name: "fall_back",
+			free: Span{
+				start: time.Date(2020, 11, 1, 0, 0, 0, 0, newYork),
+				end:   time.Date(2020, 11, 1, 7, 0, 0, 0, newYork),
+			},
+			excepted: []time.Time{
+				time.Date(2020, 11, 1, 4, 0, 0, 0, time.UTC),
+				time.Date(2020, 11, 1, 7, 0, 0, 0, time.UTC),
+				time.Date(2020, 11, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2020, 11, 1, 11, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			// clocks go forward from 02:00 EST to 03:00 EDT
+			name: "spring_forward",
+			free: Span{
+				start: time.Date(2020, 3, 8, 0, 0, 0, 0, newYork),
+				end:   time.Date(2020, 3, 8, 7, 0, 0, 0, newYork),
+			},
+			excepted: []time.Time{
+				time.Date(2020, 3, 8, 5, 0, 0, 0, time.UTC),
+				time.Date(2020, 3, 8, 8, 0, 0, 0, time.UTC),
+				time.Date(2020, 3, 8, 10, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := FindSlots(NewMany(tc.free), time.Hour, SlotOptions{Align: 2 * time.Hour})
+			var starts []time.Time
+			for _, sp := range result.Spans() {
+				assert.Equal(t, time.Duration(0), clockOf(sp.Start())%(2*time.Hour))
+				starts = append(starts, sp.Start().UTC())
+			}
+			assert.Equal(t, tc.excepted, starts)
+
+			descending := FindSlots(NewMany(tc.free), time.Hour, SlotOptions{Align: 2 * time.Hour, Order: Descending})
+			var reversed []time.Time
+			for i := len(descending.Spans()) - 1; i >= 0; i-- {
+				reversed = append(reversed, descending.Spans()[i].Start().UTC())
+			}
+			assert.Equal(t, tc.excepted, reversed)
+		})
+	}
+}
+
+func TestFindSlotsLimitLongSpan(t *testing.T) {
+	start := time.Date(2020, 10, 19, 9, 0, 0, 0, time.UTC)
+	free := NewMany(Span{start, start.AddDate(10, 0, 0)})
+	testCases := []struct {
+		name string
+		opt  SlotOptions
+
+		excepted SpanMany
+	}{
+		{
+			name:     "ascending",
+			opt:      SlotOptions{Limit: 2},
+			excepted: NewMany(Span{start, start.Add(time.Second)}, Span{start.Add(time.Second), start.Add(2 * time.Second)}),
+		},
+		{
+			name: "descending",
+			opt:  SlotOptions{Limit: 1, Order: Descending},
+			excepted: NewMany(Span{
+				start.AddDate(10, 0, 0).Add(-time.Second),
+				start.AddDate(10, 0, 0)}),
+		},
+		{
+			name: "descending_align",
+			opt:  SlotOptions{Limit: 1, Order: Descending, Align: 15 * time.Minute, BufferAfter: time.Minute},
+			excepted: NewMany(Span{
+				start.AddDate(10, 0, 0).Add(-15 * time.Minute),
+				start.AddDate(10, 0, 0).Add(-15*time.Minute + time.Second)}),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := FindSlots(free, time.Second, tc.opt)
+			assert.Equal(t, tc.excepted, result)
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package timeinterval
 
 import (
-	"errors"
 	"fmt"
 	"time"
 )
@@ -13,10 +12,10 @@ type Span struct {
 }
 
 // New initialization of a new time interval.
-
+// Returns *ValidationError wrapping ErrInverted, ErrEmpty or ErrZeroTime for invalid boundaries.
 func New(start, end time.Time) (Span, error) {
-	if afterOrEqual(start, end) {
-		return Span{}, errors.New("time start cannot be more time end")
+	if err := validate(start, end, -1); err != nil {
+		return Span{}, err
 	}
 	return Span{
 		start: start,
@@ -39,155 +38,146 @@ func (s *Span) String() string {
 	return fmt.Sprintf("%v - %v", s.Start(), s.End())
 }
 
+// Duration returning length time interval
+func (s *Span) Duration() time.Duration {
+	return s.end.Sub(s.start)
+}
+
+// In time interval with both boundaries in the location loc, nil means UTC.
+func (s *Span) In(loc *time.Location) Span {
+	if s.IsEmpty() {
+		return Span{}
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return Span{
+		start: s.start.In(loc),
+		end:   s.end.In(loc),
+	}
+}
+
 // IsEmpty  defines empty spacing
 func (s *Span) IsEmpty() bool {
 	return s.start.IsZero() && s.end.IsZero()
 }
 
-// Equal full equals of two time slots
+// Equal full equals of two time slots.
+// Boundaries are considered equal if they differ by no more than the tolerance.
 
-// offset - possible deviation from the time interval
-func (s *Span) Equal(input Span, offset ...time.Duration) bool {
-	defaultOffset := time.Second * 0
-	if len(offset) > 0 {
-		defaultOffset = offset[0]
-	}
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *Span) Equal(input Span, tolerance ...Tolerance) bool {
+	tol := toleranceOf(tolerance)
 	startSub := s.start.Sub(input.start)
 	endSub := s.end.Sub(input.end)
+	return startSub <= tol.Start && startSub >= -tol.Start &&
+		endSub <= tol.End && endSub >= -tol.End
+}
 
-	return startSub <= defaultOffset && startSub >= -defaultOffset &&
-		endSub <= defaultOffset && endSub >= -defaultOffset
+// IsIntersection check for intersection of time intervals.
+// If the input crosses a boundary of the time interval, an overlap not longer than the tolerance
+// of the input boundary inside the time interval is only touching. An input lying inside the time interval
+// or covering it on both sides intersects it whatever the tolerance.
+
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *Span) IsIntersection(input Span, tolerance ...Tolerance) bool {
+	in := s.interval()
+	overlap := in.Intersection(input.interval())
+	if overlap.IsEmpty() {
+		return false
+	}
+	tol := toleranceOf(tolerance)
+	startInside := !input.start.Before(s.start)
+	endInside := !input.end.After(s.end)
+	switch {
+	case startInside && !endInside:
+		return overlap.end.Sub(overlap.start) > tol.Start
+	case !startInside && endInside:
+		return overlap.end.Sub(overlap.start) > tol.End
+	}
+	return true
 }
 
-// IsIntersection check for intersection of time intervals
+// IsContains check contains interval.
+// The input may go beyond the time interval by no more than the tolerance.
 
-// offset - possible deviation from the time interval
-func (s *Span) IsIntersection(input Span, offset ...time.Duration) bool {
-	defaultOffset := time.Second * 0
-	if len(offset) > 0 {
-		defaultOffset = offset[0]
-	}
-	return s.start.Add(defaultOffset).Before(input.end) && s.end.After(input.start.Add(defaultOffset))
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *Span) IsContains(input Span, tolerance ...Tolerance) bool {
+	widened := s.widen(toleranceOf(tolerance))
+	return widened.IsContains(input.interval())
 }
 
-//IsContains check contains interval
-func (s *Span) IsContains(input Span, offset ...time.Duration) bool {
-	defaultOffset := time.Second * 0
-	if len(offset) > 0 {
-		defaultOffset = offset[0]
-	}
-	return beforeOrEqual(s.start.Add(-defaultOffset), input.start) &&
-		afterOrEqual(s.end.Add(defaultOffset), input.end)
-}
+// Intersection intersection of two time intervals.
+// Whether the time intervals intersect is decided as in IsIntersection, the boundaries of the result are exact.
+// The result is in the location of the start of s.
 
-// Intersection intersection of two time intervals
-func (s *Span) Intersection(input Span) Span {
-	if !s.IsIntersection(input) {
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *Span) Intersection(input Span, tolerance ...Tolerance) Span {
+	if !s.IsIntersection(input, tolerance...) {
 		return Span{}
 	}
-	if afterOrEqual(s.end, input.start) &&
-		beforeOrEqual(s.start, input.start) && beforeOrEqual(s.end, input.end) {
-		return Span{
-			start: input.start,
-			end:   s.end,
-		}
-	}
-	if beforeOrEqual(s.start, input.end) &&
-		afterOrEqual(s.end, input.end) && afterOrEqual(s.start, input.start) {
-		return Span{
-			start: s.start,
-			end:   input.end,
-		}
-	}
-	if afterOrEqual(s.start, input.start) && beforeOrEqual(s.end, input.end) {
-		return Span{
-			start: s.start,
-			end:   s.end,
-		}
-	}
-	if beforeOrEqual(s.start, input.start) && afterOrEqual(s.end, input.end) {
-		return Span{
-			start: input.start,
-			end:   input.end,
-		}
-	}
-	return Span{}
+	in := s.interval()
+	result := Span(in.Intersection(input.interval()))
+	return result.In(s.start.Location())
 }
 
 // Union union of two time intervals.
-func (s *Span) Union(input Span) SpanMany {
-	if s.isIntersectionEqual(input) {
-		return NewMany(Span{
-			start: s.minStart(input),
-			end:   s.maxEnd(input),
-		})
-	}
-	result := NewMany(
-		Span{
-			start: s.start,
-			end:   s.end,
-		},
-		Span{
-			start: input.start,
-			end:   input.end,
-		})
-	return result
+// Time intervals separated by a gap of no more than the tolerance are merged.
+// The result is in the location of the start of s.
+
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *Span) Union(input Span, tolerance ...Tolerance) SpanMany {
+	loc := s.start.Location()
+	in := s.interval()
+	if in.touches(input.widen(toleranceOf(tolerance))) {
+		result := Span(in.hull(input.interval()))
+		return NewMany(result.In(loc))
+	}
+	return NewMany(*s, input.In(loc))
 }
 
 // Except  difference in time intervals - from input (s \ input).
-func (s *Span) Except(input Span) SpanMany {
-	if !s.IsIntersection(input) {
-		return NewMany(Span{
-			start: s.start,
-			end:   s.end,
-		})
-	}
-	if afterOrEqual(s.start, input.start) && beforeOrEqual(s.end, input.end) {
-		return NewMany()
-	}
-	if s.start.Before(input.start) && s.end.After(input.end) {
-		return NewMany(
-			Span{
-				start: s.start,
-				end:   input.start,
-			},
-			Span{
-				start: input.end,
-				end:   s.end,
-			},
-		)
-	}
-	if s.start.Before(input.start) && afterOrEqual(s.end, input.start) {
-		return NewMany(Span{
-			start: s.start,
-			end:   input.start,
-		})
-	}
-	if beforeOrEqual(s.start, input.end) && s.end.After(input.end) {
-		return NewMany(Span{
-			start: input.end,
-			end:   s.end,
-		})
+// Remaining parts not longer than the tolerance are dropped. The result is in the location of the start of s.
+
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *Span) Except(input Span, tolerance ...Tolerance) SpanMany {
+	tol := toleranceOf(tolerance)
+	if !s.IsIntersection(input, tol) {
+		return NewMany(*s)
+	}
+	in := s.interval()
+	ex := in.Except(input.interval())
+	result := NewMany()
+	for _, i := range ex.intervals {
+		sp := Span(i)
+		limit := tol.End
+		if sp.start.Equal(s.start) {
+			limit = tol.Start
+		}
+		if sp.Duration() > limit {
+			result.spans = append(result.spans, sp.In(s.start.Location()))
+		}
 	}
-	return SpanMany{}
+	return result
 }
 
-func (s *Span) minStart(input Span) time.Time {
-	if s.start.Before(input.start) {
-		return s.start
-	}
-	return input.start
+// interval the time interval as the generic interval of its domain.
+func (s *Span) interval() timeInterval {
+	return timeInterval(*s)
 }
 
-func (s *Span) maxEnd(input Span) time.Time {
-	if s.end.After(input.end) {
-		return s.end
+// widen the time interval with the boundaries moved outwards by the tolerance.
+func (s *Span) widen(tol Tolerance) timeInterval {
+	return timeInterval{
+		start: s.start.Add(-tol.Start),
+		end:   s.end.Add(tol.End),
 	}
-	return input.end
 }
 
-// isIntersectionEqual checking for the intersection of time intervals.
-// The difference from the public method is that it includes cases at the junction.
-func (s *Span) isIntersectionEqual(input Span) bool {
-	return beforeOrEqual(s.start, input.end) && afterOrEqual(s.end, input.start)
+// narrow the time interval with the boundaries moved inwards by the tolerance.
+func (s *Span) narrow(tol Tolerance) timeInterval {
+	return timeInterval{
+		start: s.start.Add(tol.Start),
+		end:   s.end.Add(-tol.End),
+	}
 }
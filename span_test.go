@@ -21,14 +21,21 @@ func TestNew(t *testing.T) {
 			name:     "start equal end",
 			start:    time.Date(2022, 2, 12, 7, 30, 0, 0, time.UTC),
 			end:      time.Date(2022, 2, 12, 7, 30, 0, 0, time.UTC),
-			wantErr:  errors.New("time start cannot be more time end"),
+			wantErr:  ErrEmpty,
 			wantSpan: Span{},
 		},
 		{
 			name:     "start more end",
 			start:    time.Date(2022, 2, 12, 7, 30, 0, 1, time.UTC),
 			end:      time.Date(2022, 2, 12, 7, 30, 0, 0, time.UTC),
-			wantErr:  errors.New("time start cannot be more time end"),
+			wantErr:  ErrInverted,
+			wantSpan: Span{},
+		},
+		{
+			name:     "zero start",
+			start:    time.Time{},
+			end:      time.Date(2022, 2, 12, 7, 30, 0, 0, time.UTC),
+			wantErr:  ErrZeroTime,
 			wantSpan: Span{},
 		},
 		{
@@ -46,8 +53,16 @@ func TestNew(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			interval, err := New(tc.start, tc.end)
-			assert.Equal(t, tc.wantErr, err)
+			assert.True(t, errors.Is(err, tc.wantErr))
 			assert.Equal(t, tc.wantSpan, interval)
+			if tc.wantErr == nil {
+				return
+			}
+			var validationErr *ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, tc.start, validationErr.Start)
+			assert.Equal(t, tc.end, validationErr.End)
+			assert.Equal(t, -1, validationErr.Index)
 		})
 	}
 }
@@ -152,7 +167,7 @@ func TestEqual(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			result := tc.newInterval.Equal(tc.inputInterval, tc.offset)
+			result := tc.newInterval.Equal(tc.inputInterval, Within(tc.offset))
 			assert.Equal(t, tc.excepted, result)
 		})
 	}
@@ -257,7 +272,7 @@ func TestIsIntersection(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			result := tc.newInterval.IsIntersection(tc.inputInterval, tc.offset)
+			result := tc.newInterval.IsIntersection(tc.inputInterval, Within(tc.offset))
 			assert.Equal(t, tc.excepted, result)
 		})
 	}
@@ -688,7 +703,7 @@ func TestIsContains(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := tc.newSpan.IsContains(tc.inputSpan, tc.offset)
+			result := tc.newSpan.IsContains(tc.inputSpan, Within(tc.offset))
 			assert.Equal(t, tc.excepted, result)
 		})
 	}
@@ -23,10 +23,25 @@ func NewMany(spans ...Span) SpanMany {
 	}
 }
 
+// NewManyChecked initialization for multiple time intervals with validation of every one of them.
+// All invalid time intervals are reported at once as ValidationErrors.
+func NewManyChecked(spans ...Span) (SpanMany, error) {
+	var errs ValidationErrors
+	for i, sp := range spans {
+		if err := validate(sp.start, sp.end, i); err != nil {
+			errs = append(errs, err.(*ValidationError))
+		}
+	}
+	if len(errs) > 0 {
+		return NewMany(), errs
+	}
+	return NewMany(spans...), nil
+}
+
 // Add adding a time interval to SpanMany.
 func (s *SpanMany) Add(start time.Time, end time.Time) error {
-	if s == nil || s.spans == nil {
-		return nil
+	if s == nil {
+		return ErrNilSpanMany
 	}
 	interval, err := New(start, end)
 	if err != nil {
@@ -38,7 +53,7 @@ func (s *SpanMany) Add(start time.Time, end time.Time) error {
 
 // AddMany adding several time slots at once to the existing one SpanMany.
 func (s *SpanMany) AddMany(spans ...Span) {
-	if s.spans == nil || len(spans) == 0 {
+	if s == nil || len(spans) == 0 {
 		return
 	}
 	s.spans = append(s.spans, spans...)
@@ -62,6 +77,25 @@ func (s *SpanMany) Spans() []Span {
 	return s.spans
 }
 
+// In time intervals with both boundaries in the location loc, nil means UTC.
+func (s *SpanMany) In(loc *time.Location) SpanMany {
+	result := make([]Span, 0, len(s.spans))
+	for _, sp := range s.spans {
+		result = append(result, sp.In(loc))
+	}
+	return NewMany(result...)
+}
+
+// Duration total length of all time intervals.
+// Overlapping intervals are counted as many times as they overlap, call Union first to avoid it.
+func (s *SpanMany) Duration() time.Duration {
+	var d time.Duration
+	for _, sp := range s.spans {
+		d += sp.Duration()
+	}
+	return d
+}
+
 // Sort sorting time intervals.
 
 // st - sorting options:
@@ -85,136 +119,184 @@ func (s *SpanMany) Sort(st ...SortType) {
 // Equal full comparison of SpanMany of time intervals with one interval.
 // If there is at least one match, return true.
 
-// offset - possible deviation from the time interval.
-func (s *SpanMany) Equal(input Span, offset ...time.Duration) bool {
-	for _, s := range s.spans {
-		if s.Equal(input, offset...) {
-			return true
-		}
-	}
-	return false
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *SpanMany) Equal(input Span, tolerance ...Tolerance) bool {
+	set := s.intervals()
+	return set.anyOf(func(i timeInterval) bool {
+		sp := Span(i)
+		return sp.Equal(input, tolerance...)
+	})
 }
 
 // IsIntersection checking for intersection of an interval with one of SpanMany.
 // If there is at least one match, return true.
 
-// offset - possible deviation from the time interval.
-func (s *SpanMany) IsIntersection(input Span, offset ...time.Duration) bool {
-	for _, s := range s.spans {
-		if s.IsIntersection(input, offset...) {
-			return true
-		}
-	}
-	return false
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *SpanMany) IsIntersection(input Span, tolerance ...Tolerance) bool {
+	set := s.intervals()
+	return set.anyOf(func(i timeInterval) bool {
+		sp := Span(i)
+		return sp.IsIntersection(input, tolerance...)
+	})
 }
 
 // IsContains checking for contains of SpanMany of time intervals with one interval.
 // If there is at least one match, return true.
-func (s *SpanMany) IsContains(input Span, offset ...time.Duration) bool {
-	for _, s := range s.spans {
-		if s.IsContains(input, offset...) {
-			return true
-		}
-	}
-	return false
+
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *SpanMany) IsContains(input Span, tolerance ...Tolerance) bool {
+	set := s.intervals()
+	return set.IsContains(input.narrow(toleranceOf(tolerance)))
 }
 
 // ExceptionIfIntersection excludes periods from the SpanMany if there is an intersection with another SpanMany.
 
-// offset - possible deviation from the time interval
-func (s *SpanMany) ExceptionIfIntersection(input SpanMany, offset ...time.Duration) SpanMany {
-	var listSpans []Span
-	for _, s := range s.spans {
-		if input.IsIntersection(s, offset...) {
-			continue
-		}
-		listSpans = append(listSpans, s)
-	}
-	return NewMany(listSpans...)
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *SpanMany) ExceptionIfIntersection(input SpanMany, tolerance ...Tolerance) SpanMany {
+	set := s.intervals()
+	return spanManyOf(set.filter(func(i timeInterval) bool {
+		return !input.IsIntersection(Span(i), tolerance...)
+	}))
 }
 
 // ExceptionIfNotEqual excludes periods from the SpanMany if it does not meet any equality with another SpanMany.
 
-// offset - possible deviation from the time interval
-func (s *SpanMany) ExceptionIfNotEqual(input SpanMany, offset ...time.Duration) SpanMany {
-	var listSpans []Span
-	for _, s := range s.spans {
-		if input.Equal(s, offset...) {
-			listSpans = append(listSpans, s)
-			continue
-		}
-	}
-	return NewMany(listSpans...)
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *SpanMany) ExceptionIfNotEqual(input SpanMany, tolerance ...Tolerance) SpanMany {
+	set := s.intervals()
+	return spanManyOf(set.filter(func(i timeInterval) bool {
+		return input.Equal(Span(i), tolerance...)
+	}))
 }
 
 // ExceptionIfNotContains excludes periods from SpanMany if it does not contain any interval with another SpanMany.
-func (s *SpanMany) ExceptionIfNotContains(input SpanMany, offset ...time.Duration) SpanMany {
-	var listSpans []Span
-	for _, sp := range s.spans {
-		if input.IsContains(sp, offset...) {
-			listSpans = append(listSpans, sp)
-			continue
-		}
-	}
-	return NewMany(listSpans...)
+
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *SpanMany) ExceptionIfNotContains(input SpanMany, tolerance ...Tolerance) SpanMany {
+	set := s.intervals()
+	return spanManyOf(set.filter(func(i timeInterval) bool {
+		return input.IsContains(Span(i), tolerance...)
+	}))
 }
 
 // Intersection intersecting time slots (SpanMany) with one time slot (Span).
-func (s *SpanMany) Intersection(input Span) SpanMany {
-	if len(s.spans) == 0 {
-		return NewMany()
-	}
-	var intersectionList []Span
-	for _, sp := range s.spans {
-		intersectionSpan := sp.Intersection(input)
-		if !intersectionSpan.IsEmpty() {
-			intersectionList = append(intersectionList, intersectionSpan)
-		}
-	}
-	return NewMany(intersectionList...)
+// Whether the time intervals intersect is decided as in IsIntersection, the boundaries of the result are exact.
+// The result is in the location of the time intervals of SpanMany, UTC if they are in different locations.
+
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *SpanMany) Intersection(input Span, tolerance ...Tolerance) SpanMany {
+	set := s.intervals()
+	intersecting := set.filter(func(i timeInterval) bool {
+		sp := Span(i)
+		return sp.IsIntersection(input, tolerance...)
+	})
+	result := spanManyOf(intersecting.Intersection(input.interval()))
+	return result.In(s.location())
 }
 
 // Except difference between each array element SpanMany and input Span  (s[i] \ input).
 // Returns the elements of SpanMany, where the time interval remains after the Except operation with input.
-// Before returning the final result is sorted and merged.
-func (s *SpanMany) Except(input Span) SpanMany {
-	result := NewMany()
-	for _, sp := range s.spans {
-		ex := sp.Except(input)
-		if len(ex.spans) == 0 {
-			continue
-		}
-		result.AddMany(ex.spans...)
-	}
-	return result.Union()
+// Before returning the final result is sorted and merged, it is in the location of the time intervals of SpanMany,
+// UTC if they are in different locations.
+
+// tolerance - possible deviation of the boundaries of the time interval.
+func (s *SpanMany) Except(input Span, tolerance ...Tolerance) SpanMany {
+	set := s.intervals()
+	result := spanManyOf(set.flatUnion(func(i timeInterval) []timeInterval {
+		sp := Span(i)
+		ex := sp.Except(input, tolerance...)
+		return ex.intervals().intervals
+	}))
+	return result.In(s.location())
 }
 
 // Union concatenation SpanMany of array SpanMany.
+// The result is sorted and merged, the receiver is not modified. The result is in the location
+// of all time intervals of SpanMany and input, UTC if they are in different locations.
 func (s *SpanMany) Union(input ...SpanMany) SpanMany {
-	var result []Span
+	return s.UnionWithin(Tolerance{}, input...)
+}
+
+// UnionWithin concatenation SpanMany of array SpanMany,
+// time intervals separated by a gap of no more than the tolerance are merged.
+// The result is sorted and merged, the receiver is not modified. The result is in the location
+// of all time intervals of SpanMany and input, UTC if they are in different locations.
+func (s *SpanMany) UnionWithin(tolerance Tolerance, input ...SpanMany) SpanMany {
+	tol := toleranceOf([]Tolerance{tolerance})
+	all := NewMany(append([]Span(nil), s.spans...)...)
 	for _, inp := range input {
-		s.spans = append(s.spans, inp.spans...)
+		all.spans = append(all.spans, inp.spans...)
+	}
+	loc := all.location()
+	all.Sort()
+
+	set := all.intervals()
+	merged := mergeSorted(set.intervals, func(last, next timeInterval) bool {
+		sp := Span(last)
+		return next.touches(sp.widen(tol))
+	})
+	result := make([]Span, 0, len(merged))
+	for _, i := range merged {
+		sp := Span(i)
+		result = append(result, sp.In(loc))
 	}
-	s.Sort()
+	return NewMany(result...)
+}
 
-	var bufferSpan Span
+// location location of the results of set operations - the location shared by all non-empty time intervals,
+// UTC if they are in different locations. It does not depend on the order of the time intervals.
+func (s *SpanMany) location() *time.Location {
+	var loc *time.Location
 	for _, sp := range s.spans {
-		if bufferSpan.start.IsZero() {
-			bufferSpan = sp
+		if sp.IsEmpty() {
 			continue
 		}
-		if sp.isIntersectionEqual(bufferSpan) {
-			bufferSpan = Span{
-				start: sp.minStart(bufferSpan),
-				end:   sp.maxEnd(bufferSpan),
+		for _, l := range []*time.Location{sp.start.Location(), sp.end.Location()} {
+			switch {
+			case loc == nil:
+				loc = l
+			case !sameLocation(loc, l):
+				return time.UTC
 			}
-			continue
 		}
-		result = append(result, bufferSpan)
-		bufferSpan = sp
 	}
-	if !bufferSpan.start.IsZero() {
-		result = append(result, bufferSpan)
+	return loc
+}
+
+// sameLocation locations are the same or loaded from the same time zone.
+func sameLocation(l1, l2 *time.Location) bool {
+	return l1 == l2 || l1.String() != "" && l1.String() == l2.String()
+}
+
+// intervals time intervals as the generic set of their domain.
+func (s *SpanMany) intervals() IntervalSet[time.Time, Chronological] {
+	result := make([]timeInterval, 0, len(s.spans))
+	for _, sp := range s.spans {
+		result = append(result, sp.interval())
+	}
+	return NewIntervalSet(result...)
+}
+
+// spanManyOf time intervals of the generic set of their domain.
+func spanManyOf(set IntervalSet[time.Time, Chronological]) SpanMany {
+	result := make([]Span, 0, len(set.intervals))
+	for _, i := range set.intervals {
+		result = append(result, Span(i))
 	}
 	return NewMany(result...)
 }
+
+// normalized sorted and merged copy of SpanMany, the receiver is not modified.
+func (s *SpanMany) normalized() SpanMany {
+	return s.Union()
+}
+
+// exceptMany difference between SpanMany and every time interval of input.
+// The result is sorted and merged, the receiver is not modified.
+func (s *SpanMany) exceptMany(input SpanMany) SpanMany {
+	result := s.normalized()
+	for _, sp := range input.spans {
+		result = result.Except(sp)
+	}
+	return result
+}
@@ -114,7 +114,7 @@ func TestEqualMany(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			result := tc.newIntervalMany.Equal(tc.inputInterval, tc.offset)
+			result := tc.newIntervalMany.Equal(tc.inputInterval, Within(tc.offset))
 			assert.Equal(t, tc.excepted, result)
 		})
 	}
@@ -0,0 +1,110 @@
+package timeinterval
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Token identifier of a reservation in SyncCalendar.
+type Token uint64
+
+// Reservation time interval reserved in SyncCalendar.
+type Reservation struct {
+	Token Token
+	Span  Span
+}
+
+// SyncCalendar calendar of reservations safe for concurrent use.
+// Writers are serialized, readers work with immutable snapshots and never wait for the lock.
+// The zero value is an empty calendar ready to use.
+type SyncCalendar struct {
+	mu        sync.Mutex
+	lastToken Token
+	// snapshot []Reservation, replaced as a whole on every change and never modified after storing.
+	snapshot atomic.Value
+}
+
+// NewSyncCalendar initialization of a new empty SyncCalendar.
+func NewSyncCalendar() *SyncCalendar {
+	c := &SyncCalendar{}
+	c.snapshot.Store([]Reservation{})
+	return c
+}
+
+// TryReserve atomically checks that the time interval is free and reserves it.
+// If the time interval intersects existing reservations *ConflictError is returned.
+func (c *SyncCalendar) TryReserve(span Span) (Token, error) {
+	if _, err := New(span.start, span.end); err != nil {
+		return 0, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.reservations()
+	var conflicts []Span
+	for _, r := range current {
+		if r.Span.IsIntersection(span) {
+			conflicts = append(conflicts, r.Span)
+		}
+	}
+	if len(conflicts) > 0 {
+		return 0, &ConflictError{Conflicts: NewMany(conflicts...)}
+	}
+
+	c.lastToken++
+	next := make([]Reservation, 0, len(current)+1)
+	next = append(next, current...)
+	next = append(next, Reservation{Token: c.lastToken, Span: span})
+	c.snapshot.Store(next)
+	return c.lastToken, nil
+}
+
+// Release removes the reservation with the token.
+func (c *SyncCalendar) Release(token Token) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.reservations()
+	for i, r := range current {
+		if r.Token != token {
+			continue
+		}
+		next := make([]Reservation, 0, len(current)-1)
+		next = append(next, current[:i]...)
+		next = append(next, current[i+1:]...)
+		c.snapshot.Store(next)
+		return nil
+	}
+	return ErrUnknownToken
+}
+
+// Reservations get a copy of all reservations at the moment of the call.
+func (c *SyncCalendar) Reservations() []Reservation {
+	return append([]Reservation{}, c.reservations()...)
+}
+
+// Snapshot get time intervals of all reservations at the moment of the call.
+func (c *SyncCalendar) Snapshot() SpanMany {
+	current := c.reservations()
+	spans := make([]Span, 0, len(current))
+	for _, r := range current {
+		spans = append(spans, r.Span)
+	}
+	return NewMany(spans...)
+}
+
+// IsFree checking that the time interval does not intersect any reservation at the moment of the call.
+func (c *SyncCalendar) IsFree(span Span) bool {
+	for _, r := range c.reservations() {
+		if r.Span.IsIntersection(span) {
+			return false
+		}
+	}
+	return true
+}
+
+// reservations current snapshot, nil if nothing has been stored yet (the zero value of SyncCalendar).
+func (c *SyncCalendar) reservations() []Reservation {
+	current, _ := c.snapshot.Load().([]Reservation)
+	return current
+}
@@ -0,0 +1,102 @@
+package timeinterval
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncCalendarTryReserve(t *testing.T) {
+	c := NewSyncCalendar()
+	token, err := c.TryReserve(hours(9, 11))
+	assert.NoError(t, err)
+
+	_, err = c.TryReserve(hours(10, 12))
+	var conflictErr *ConflictError
+	assert.True(t, errors.As(err, &conflictErr))
+	assert.Equal(t, NewMany(hours(9, 11)), conflictErr.Conflicts)
+
+	token2, err := c.TryReserve(hours(11, 12))
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, token2)
+	assert.Equal(t, NewMany(hours(9, 11), hours(11, 12)), c.Snapshot())
+	assert.False(t, c.IsFree(hours(10, 12)))
+
+	_, err = c.TryReserve(Span{})
+	assert.Error(t, err)
+
+	assert.NoError(t, c.Release(token))
+	assert.Equal(t, ErrUnknownToken, c.Release(token))
+	assert.Equal(t, []Reservation{{Token: token2, Span: hours(11, 12)}}, c.Reservations())
+	assert.True(t, c.IsFree(hours(10, 11)))
+}
+
+func TestSyncCalendarZeroValue(t *testing.T) {
+	var c SyncCalendar
+	assert.True(t, c.IsFree(hours(9, 11)))
+	assert.Equal(t, []Reservation{}, c.Reservations())
+	assert.Equal(t, NewMany(), c.Snapshot())
+	assert.Equal(t, ErrUnknownToken, c.Release(1))
+
+	token, err := c.TryReserve(hours(9, 11))
+	assert.NoError(t, err)
+	assert.False(t, c.IsFree(hours(10, 12)))
+	assert.NoError(t, c.Release(token))
+	assert.True(t, c.IsFree(hours(10, 12)))
+}
+
+func TestSyncCalendarConcurrent(t *testing.T) {
+	c := NewSyncCalendar()
+	start := time.Date(2020, 10, 19, 0, 0, 0, 0, time.UTC)
+	const (
+		goroutines = 32
+		slots      = 50
+	)
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		success = make(map[int]int)
+	)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < slots; i++ {
+				span := Span{start.Add(time.Duration(i) * time.Hour), start.Add(time.Duration(i+1) * time.Hour)}
+				token, err := c.TryReserve(span)
+				if err != nil {
+					continue
+				}
+				mu.Lock()
+				success[i]++
+				mu.Unlock()
+				snapshot := c.Snapshot()
+				assert.True(t, snapshot.IsIntersection(span))
+				if i%2 == 0 {
+					assert.NoError(t, c.Release(token))
+				}
+			}
+		}()
+	}
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < slots; i++ {
+				snapshot := c.Snapshot()
+				assert.LessOrEqual(t, snapshot.MaxDepth(), 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	snapshot := c.Snapshot()
+	assert.Equal(t, 1, snapshot.MaxDepth())
+	assert.Len(t, c.Reservations(), slots/2)
+	for i := 1; i < slots; i += 2 {
+		assert.Equal(t, 1, success[i])
+	}
+}
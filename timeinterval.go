@@ -18,3 +18,20 @@ func beforeOrEqual(t1, t2 time.Time) bool {
 func afterOrEqual(t1, t2 time.Time) bool {
 	return t1.After(t2) || t1.Equal(t2)
 }
+
+// atClock time at the wall clock (time elapsed since midnight) of the date in the location.
+// Values out of range are normalized the same way as in time.Date.
+func atClock(year int, month time.Month, day int, clock time.Duration, loc *time.Location) time.Time {
+	hour := int(clock / time.Hour)
+	min := int(clock % time.Hour / time.Minute)
+	sec := int(clock % time.Minute / time.Second)
+	nsec := int(clock % time.Second)
+	return time.Date(year, month, day, hour, min, sec, nsec, loc)
+}
+
+// clockOf wall clock of t in its location (time elapsed since midnight by the clock on the wall).
+func clockOf(t time.Time) time.Duration {
+	hour, min, sec := t.Clock()
+	return time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(t.Nanosecond())
+}
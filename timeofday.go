@@ -0,0 +1,119 @@
+package timeinterval
+
+import "time"
+
+// TimeOfDayRange recurring daily wall clock time range, for example a night shift 22:00–06:00.
+// Unlike Span it has no date, so the end can be before the start, then the range crosses midnight.
+type TimeOfDayRange struct {
+	start time.Duration
+	end   time.Duration
+}
+
+// NewTimeOfDayRange initialization of a new wall clock time range.
+// start and end are the time elapsed since midnight, if end is before start the range crosses midnight.
+// Returns *ClockRangeError wrapping ErrClockOutOfRange or ErrEmpty if the range is invalid.
+func NewTimeOfDayRange(start, end time.Duration) (TimeOfDayRange, error) {
+	if err := validateClockRange(-1, start, end); err != nil {
+		return TimeOfDayRange{}, err
+	}
+	return TimeOfDayRange{
+		start: start,
+		end:   end,
+	}, nil
+}
+
+// Start returning start wall clock time
+func (r *TimeOfDayRange) Start() time.Duration {
+	return r.start
+}
+
+// End returning end wall clock time
+func (r *TimeOfDayRange) End() time.Duration {
+	return r.end
+}
+
+// CrossesMidnight defines the range ending on the next day.
+func (r *TimeOfDayRange) CrossesMidnight() bool {
+	return r.end < r.start
+}
+
+// Contains checking that the wall clock of t in its location is within the range.
+func (r *TimeOfDayRange) Contains(t time.Time) bool {
+	clock := clockOf(t)
+	if r.CrossesMidnight() {
+		return clock >= r.start || clock < r.end
+	}
+	return clock >= r.start && clock < r.end
+}
+
+// Expand time intervals of the range starting on every day from the date of from to the date of to inclusive.
+// Dates are taken in the location, wall clock times are converted on every day separately,
+// so on daylight saving time days a night shift 22:00–06:00 lasts 7 or 9 hours.
+func (r *TimeOfDayRange) Expand(from, to time.Time, loc *time.Location) SpanMany {
+	result := NewMany()
+	if loc == nil {
+		loc = time.UTC
+	}
+	year, month, day := from.In(loc).Date()
+	last := to.In(loc)
+	end := r.end
+	if r.CrossesMidnight() {
+		end += dayLength
+	}
+	for ; !dateAfter(time.Date(year, month, day, 0, 0, 0, 0, loc), last); day++ {
+		spanStart := atClock(year, month, day, r.start, loc)
+		spanEnd := atClock(year, month, day, end, loc)
+		if spanStart.Before(spanEnd) {
+			result.AddMany(Span{start: spanStart, end: spanEnd})
+		}
+	}
+	return result
+}
+
+// Intersection intersection of the range repeated every day with the time interval.
+// Wall clock times are taken in the location of the start of the input, the result is sorted and merged.
+func (r *TimeOfDayRange) Intersection(input Span) SpanMany {
+	if !input.start.Before(input.end) {
+		return NewMany()
+	}
+	loc := input.start.Location()
+	expanded := r.Expand(input.start.AddDate(0, 0, -1), input.end, loc)
+	result := expanded.Intersection(input)
+	return result.Union()
+}
+
+// validateClockRange checking wall clock time range of the day of the week (-1 for any day):
+// the day of the week is valid, start within a day, end within a day and not equal start.
+// Returns *ClockRangeError wrapping ErrInvalidWeekday, ErrClockOutOfRange or ErrEmpty.
+func validateClockRange(weekday time.Weekday, start, end time.Duration) error {
+	var reason error
+	switch {
+	case weekday < -1 || weekday > time.Saturday:
+		reason = ErrInvalidWeekday
+	case start < 0 || start >= dayLength || end <= 0 || end > dayLength:
+		reason = ErrClockOutOfRange
+	case start == end:
+		reason = ErrEmpty
+	default:
+		return nil
+	}
+	return &ClockRangeError{
+		Weekday: weekday,
+		Start:   start,
+		End:     end,
+		Err:     reason,
+	}
+}
+
+// dateAfter checking that the date of t1 is after the date of t2, both in the location of t2.
+func dateAfter(t1, t2 time.Time) bool {
+	y1, m1, d1 := t1.In(t2.Location()).Date()
+	y2, m2, d2 := t2.Date()
+	if y1 != y2 {
+		return y1 > y2
+	}
+	if m1 != m2 {
+		return m1 > m2
+	}
+	return d1 > d2
+}
@@ -0,0 +1,146 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimeOfDayRange(t *testing.T) {
+	testCases := []struct {
+		name       string
+		start, end time.Duration
+		err        error
+	}{
+		{name: "day", start: 9 * time.Hour, end: 18 * time.Hour},
+		{name: "night", start: 22 * time.Hour, end: 6 * time.Hour},
+		{name: "whole_day", start: 0, end: 24 * time.Hour},
+		{name: "equal", start: 9 * time.Hour, end: 9 * time.Hour, err: ErrEmpty},
+		{name: "negative", start: -time.Hour, end: 9 * time.Hour, err: ErrClockOutOfRange},
+		{name: "more_day", start: 9 * time.Hour, end: 25 * time.Hour, err: ErrClockOutOfRange},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := NewTimeOfDayRange(tc.start, tc.end)
+			if tc.err != nil {
+				var clockErr *ClockRangeError
+				assert.True(t, errors.As(err, &clockErr))
+				assert.Equal(t, time.Weekday(-1), clockErr.Weekday)
+				assert.Equal(t, tc.start, clockErr.Start)
+				assert.Equal(t, tc.end, clockErr.End)
+				assert.True(t, errors.Is(err, tc.err))
+				assert.Equal(t, TimeOfDayRange{}, r)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.start, r.Start())
+			assert.Equal(t, tc.end, r.End())
+		})
+	}
+}
+
+func TestTimeOfDayRangeContains(t *testing.T) {
+	day, err := NewTimeOfDayRange(9*time.Hour, 18*time.Hour)
+	require.NoError(t, err)
+	night, err := NewTimeOfDayRange(22*time.Hour, 6*time.Hour)
+	require.NoError(t, err)
+	assert.False(t, day.CrossesMidnight())
+	assert.True(t, night.CrossesMidnight())
+
+	testCases := []struct {
+		name      string
+		t         time.Time
+		dayWant   bool
+		nightWant bool
+	}{
+		{name: "morning", t: time.Date(2020, 10, 19, 5, 59, 0, 0, time.UTC), nightWant: true},
+		{name: "night_end", t: time.Date(2020, 10, 19, 6, 0, 0, 0, time.UTC)},
+		{name: "day_start", t: time.Date(2020, 10, 19, 9, 0, 0, 0, time.UTC), dayWant: true},
+		{name: "day_end", t: time.Date(2020, 10, 19, 18, 0, 0, 0, time.UTC)},
+		{name: "night_start", t: time.Date(2020, 10, 19, 22, 0, 0, 0, time.UTC), nightWant: true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.dayWant, day.Contains(tc.t))
+			assert.Equal(t, tc.nightWant, night.Contains(tc.t))
+		})
+	}
+}
+
+func TestTimeOfDayRangeExpand(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	night, err := NewTimeOfDayRange(22*time.Hour, 6*time.Hour)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		from, to time.Time
+
+		excepted []time.Duration
+	}{
+		{
+			name:     "regular",
+			from:     time.Date(2020, 10, 19, 12, 0, 0, 0, newYork),
+			to:       time.Date(2020, 10, 21, 0, 0, 0, 0, newYork),
+			excepted: []time.Duration{8 * time.Hour, 8 * time.Hour, 8 * time.Hour},
+		},
+		{
+			name:     "daylight_saving_time_start",
+			from:     time.Date(2020, 3, 7, 0, 0, 0, 0, newYork),
+			to:       time.Date(2020, 3, 8, 0, 0, 0, 0, newYork),
+			excepted: []time.Duration{7 * time.Hour, 8 * time.Hour},
+		},
+		{
+			name:     "daylight_saving_time_end",
+			from:     time.Date(2020, 10, 31, 0, 0, 0, 0, newYork),
+			to:       time.Date(2020, 11, 1, 0, 0, 0, 0, newYork),
+			excepted: []time.Duration{9 * time.Hour, 8 * time.Hour},
+		},
+		{
+			name: "to_before_from",
+			from: time.Date(2020, 10, 21, 0, 0, 0, 0, newYork),
+			to:   time.Date(2020, 10, 20, 0, 0, 0, 0, newYork),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := night.Expand(tc.from, tc.to, newYork)
+			var durations []time.Duration
+			for _, sp := range result.Spans() {
+				assert.Equal(t, 22, sp.Start().Hour())
+				assert.Equal(t, 6, sp.End().Hour())
+				durations = append(durations, sp.Duration())
+			}
+			assert.Equal(t, tc.excepted, durations)
+		})
+	}
+}
+
+func TestTimeOfDayRangeIntersection(t *testing.T) {
+	night, err := NewTimeOfDayRange(22*time.Hour, 6*time.Hour)
+	require.NoError(t, err)
+
+	result := night.Intersection(Span{
+		time.Date(2020, 10, 19, 3, 0, 0, 0, time.UTC),
+		time.Date(2020, 10, 20, 23, 0, 0, 0, time.UTC),
+	})
+	assert.Equal(t, NewMany(
+		Span{
+			time.Date(2020, 10, 19, 3, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 6, 0, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 10, 19, 22, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 20, 6, 0, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 10, 20, 22, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 20, 23, 0, 0, 0, time.UTC)},
+	), result)
+	assert.Equal(t, NewMany(), night.Intersection(Span{}))
+}
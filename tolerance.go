@@ -0,0 +1,42 @@
+package timeinterval
+
+import "time"
+
+// Tolerance possible deviation of the boundaries of time intervals.
+// Two boundaries are considered coincident if they differ by no more than the tolerance:
+// Start is applied to the start of the input time interval, End - to its end.
+// The same rule is used by every operation of Span and SpanMany:
+//   - Equal: the starts and the ends coincide;
+//   - IsContains: the input may go beyond the time interval by the tolerance;
+//   - IsIntersection, Intersection, Except: an overlap at the edge not longer than the tolerance is only touching,
+//     an input inside the time interval always intersects it;
+//   - Union: a gap not longer than the tolerance is closed.
+//
+// Negative values are treated as zero.
+type Tolerance struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// Within the same tolerance d for the start and the end.
+func Within(d time.Duration) Tolerance {
+	return Tolerance{
+		Start: d,
+		End:   d,
+	}
+}
+
+// toleranceOf optional tolerance, zero by default.
+func toleranceOf(tolerance []Tolerance) Tolerance {
+	var tol Tolerance
+	if len(tolerance) > 0 {
+		tol = tolerance[0]
+	}
+	if tol.Start < 0 {
+		tol.Start = 0
+	}
+	if tol.End < 0 {
+		tol.End = 0
+	}
+	return tol
+}
@@ -0,0 +1,157 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToleranceSeparateBoundaries(t *testing.T) {
+	s := minuteSpan(0, 60)
+	input := minuteSpan(3, 62)
+
+	assert.False(t, s.Equal(input, Within(2*time.Minute)))
+	assert.True(t, s.Equal(input, Tolerance{Start: 3 * time.Minute, End: 2 * time.Minute}))
+	assert.False(t, s.Equal(input, Tolerance{Start: 2 * time.Minute, End: 3 * time.Minute}))
+
+	assert.False(t, s.IsContains(input))
+	assert.True(t, s.IsContains(input, Tolerance{End: 2 * time.Minute}))
+	assert.False(t, s.IsContains(input, Tolerance{Start: 2 * time.Minute}))
+
+	// input starts 3 minutes before the end of s
+	late := minuteSpan(57, 90)
+	assert.True(t, s.IsIntersection(late, Tolerance{End: time.Hour}))
+	assert.False(t, s.IsIntersection(late, Tolerance{Start: 3 * time.Minute}))
+	assert.True(t, late.IsIntersection(s, Tolerance{Start: time.Hour}))
+	assert.False(t, late.IsIntersection(s, Tolerance{End: 3 * time.Minute}))
+
+	// negative values are treated as zero
+	assert.True(t, s.Equal(s, Within(-time.Minute)))
+	assert.False(t, s.Equal(input, Within(-time.Minute)))
+}
+
+func TestToleranceOperations(t *testing.T) {
+	testCases := []struct {
+		name      string
+		s         Span
+		input     Span
+		tolerance Tolerance
+
+		exceptedIntersection Span
+		exceptedExcept       SpanMany
+		exceptedUnion        SpanMany
+	}{
+		{
+			name:                 "overlap_within_tolerance",
+			s:                    minuteSpan(0, 60),
+			input:                minuteSpan(58, 90),
+			tolerance:            Within(2 * time.Minute),
+			exceptedIntersection: Span{},
+			exceptedExcept:       NewMany(minuteSpan(0, 60)),
+			exceptedUnion:        NewMany(minuteSpan(0, 90)),
+		},
+		{
+			name:                 "overlap_more_tolerance",
+			s:                    minuteSpan(0, 60),
+			input:                minuteSpan(57, 90),
+			tolerance:            Within(2 * time.Minute),
+			exceptedIntersection: minuteSpan(57, 60),
+			exceptedExcept:       NewMany(minuteSpan(0, 57)),
+			exceptedUnion:        NewMany(minuteSpan(0, 90)),
+		},
+		{
+			name:                 "gap_within_tolerance",
+			s:                    minuteSpan(0, 60),
+			input:                minuteSpan(62, 90),
+			tolerance:            Within(2 * time.Minute),
+			exceptedIntersection: Span{},
+			exceptedExcept:       NewMany(minuteSpan(0, 60)),
+			exceptedUnion:        NewMany(minuteSpan(0, 90)),
+		},
+		{
+			name:                 "gap_more_tolerance",
+			s:                    minuteSpan(0, 60),
+			input:                minuteSpan(63, 90),
+			tolerance:            Within(2 * time.Minute),
+			exceptedIntersection: Span{},
+			exceptedExcept:       NewMany(minuteSpan(0, 60)),
+			exceptedUnion:        NewMany(minuteSpan(0, 60), minuteSpan(63, 90)),
+		},
+		{
+			name:                 "slivers_dropped",
+			s:                    minuteSpan(0, 60),
+			input:                minuteSpan(1, 58),
+			tolerance:            Tolerance{Start: time.Minute, End: 2 * time.Minute},
+			exceptedIntersection: minuteSpan(1, 58),
+			exceptedExcept:       NewMany(),
+			exceptedUnion:        NewMany(minuteSpan(0, 60)),
+		},
+		{
+			name:                 "one_sliver_dropped",
+			s:                    minuteSpan(0, 60),
+			input:                minuteSpan(1, 58),
+			tolerance:            Within(time.Minute),
+			exceptedIntersection: minuteSpan(1, 58),
+			exceptedExcept:       NewMany(minuteSpan(58, 60)),
+			exceptedUnion:        NewMany(minuteSpan(0, 60)),
+		},
+		{
+			name:                 "edge_overlap_equal_tolerance",
+			s:                    hours(10, 20),
+			input:                hours(19, 21),
+			tolerance:            Within(time.Hour),
+			exceptedIntersection: Span{},
+			exceptedExcept:       NewMany(hours(10, 20)),
+			exceptedUnion:        NewMany(hours(10, 21)),
+		},
+		{
+			name:                 "contained_equal_tolerance",
+			s:                    hours(10, 20),
+			input:                hours(12, 13),
+			tolerance:            Within(time.Hour),
+			exceptedIntersection: hours(12, 13),
+			exceptedExcept:       NewMany(hours(10, 12), hours(13, 20)),
+			exceptedUnion:        NewMany(hours(10, 20)),
+		},
+		{
+			name:                 "contained_more_tolerance",
+			s:                    hours(10, 20),
+			input:                hours(12, 13),
+			tolerance:            Within(5 * time.Hour),
+			exceptedIntersection: hours(12, 13),
+			exceptedExcept:       NewMany(hours(13, 20)),
+			exceptedUnion:        NewMany(hours(10, 20)),
+		},
+		{
+			name:                 "covering",
+			s:                    hours(12, 13),
+			input:                hours(10, 20),
+			tolerance:            Within(5 * time.Hour),
+			exceptedIntersection: hours(12, 13),
+			exceptedExcept:       NewMany(),
+			exceptedUnion:        NewMany(hours(10, 20)),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.s.IsIntersection(tc.input, tc.tolerance), tc.input.IsIntersection(tc.s, tc.tolerance))
+			assert.Equal(t, tc.exceptedIntersection, tc.s.Intersection(tc.input, tc.tolerance))
+			assert.Equal(t, tc.exceptedExcept, tc.s.Except(tc.input, tc.tolerance))
+			assert.Equal(t, tc.exceptedUnion, tc.s.Union(tc.input, tc.tolerance))
+		})
+	}
+}
+
+func TestToleranceSpanMany(t *testing.T) {
+	many := NewMany(minuteSpan(0, 10), minuteSpan(11, 20), minuteSpan(23, 30))
+	assert.Equal(t, NewMany(minuteSpan(0, 20), minuteSpan(23, 30)), many.UnionWithin(Within(time.Minute)))
+
+	many = NewMany(minuteSpan(0, 10), minuteSpan(11, 20))
+	assert.Equal(t, NewMany(minuteSpan(0, 30)), many.UnionWithin(Within(3*time.Minute), NewMany(minuteSpan(23, 30))))
+
+	many = NewMany(minuteSpan(0, 10), minuteSpan(20, 30))
+	assert.Equal(t, NewMany(minuteSpan(5, 10)), many.Intersection(minuteSpan(5, 21), Within(time.Minute)))
+	assert.Equal(t, NewMany(minuteSpan(0, 5), minuteSpan(20, 30)), many.Except(minuteSpan(5, 21), Within(time.Minute)))
+}
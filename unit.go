@@ -0,0 +1,42 @@
+package timeinterval
+
+import "time"
+
+// Unit calendar unit of time.
+type Unit int
+
+const (
+	// UnitDay calendar day.
+	UnitDay Unit = iota
+	// UnitWeek ISO week starting on Monday, or on the day set by PeriodOptions.
+	UnitWeek
+	// UnitMonth calendar month.
+	UnitMonth
+	// UnitQuarter calendar quarter, or fiscal quarter with PeriodOptions.
+	UnitQuarter
+	// UnitYear calendar year, or fiscal year with PeriodOptions.
+	UnitYear
+)
+
+// unitStart beginning of the calendar unit containing t in the location.
+func unitStart(t time.Time, u Unit, loc *time.Location) time.Time {
+	return PeriodOptions{}.unitStart(t, u, loc)
+}
+
+// unitAdd moving the beginning of a calendar unit by n units.
+func unitAdd(start time.Time, u Unit, n int) time.Time {
+	year, month, day := start.Date()
+	switch u {
+	case UnitWeek:
+		day += 7 * n
+	case UnitMonth:
+		month += time.Month(n)
+	case UnitQuarter:
+		month += time.Month(3 * n)
+	case UnitYear:
+		year += n
+	default:
+		day += n
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, start.Location())
+}
@@ -0,0 +1,114 @@
+package timeinterval
+
+import (
+	"fmt"
+	"time"
+)
+
+// NonexistentPolicy resolution of a wall clock time skipped when clocks go forward (02:30 on a spring DST day).
+type NonexistentPolicy int
+
+const (
+	// ShiftForward moving the time forward by the length of the gap, 02:30 becomes 03:30 (default).
+	ShiftForward NonexistentPolicy = iota
+	// ShiftBackward moving the time backward by the length of the gap, 02:30 becomes 01:30.
+	ShiftBackward
+	// NonexistentError returning ErrNonexistentTime.
+	NonexistentError
+)
+
+// AmbiguousPolicy resolution of a wall clock time repeated when clocks go back (01:30 on an autumn DST day).
+type AmbiguousPolicy int
+
+const (
+	// Earlier taking the first occurrence, before clocks go back (default).
+	Earlier AmbiguousPolicy = iota
+	// Later taking the second occurrence, after clocks go back.
+	Later
+	// AmbiguousError returning ErrAmbiguousTime.
+	AmbiguousError
+)
+
+// WallClockPolicy resolution of wall clock times that do not exist or occur twice in a location.
+type WallClockPolicy struct {
+	Nonexistent NonexistentPolicy
+	Ambiguous   AmbiguousPolicy
+}
+
+// WallClock instant of the wall clock (time elapsed since midnight) of the date in the location.
+// Unlike time.Date, wall clock times skipped or repeated by a time zone transition
+// are resolved by the policy (ShiftForward and Earlier by default) or reported as an error.
+func WallClock(date Date, clock time.Duration, loc *time.Location, policy ...WallClockPolicy) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	var p WallClockPolicy
+	if len(policy) > 0 {
+		p = policy[0]
+	}
+	wall := atClock(date.Year, date.Month, date.Day, clock, time.UTC)
+	// offsets before and after a possible transition, transitions are assumed to be more than a day apart
+	_, offsetBefore := wall.Add(-dayLength).In(loc).Zone()
+	_, offsetAfter := wall.Add(dayLength).In(loc).Zone()
+	before := wall.Add(-time.Duration(offsetBefore) * time.Second).In(loc)
+	after := wall.Add(-time.Duration(offsetAfter) * time.Second).In(loc)
+	beforeValid := sameWallClock(before, wall)
+	afterValid := sameWallClock(after, wall)
+
+	switch {
+	case beforeValid && afterValid && !before.Equal(after):
+		switch p.Ambiguous {
+		case Later:
+			if after.Before(before) {
+				return before, nil
+			}
+			return after, nil
+		case AmbiguousError:
+			return time.Time{}, fmt.Errorf("%w: %v %v in %v", ErrAmbiguousTime, date, clock, loc)
+		}
+		if after.Before(before) {
+			return after, nil
+		}
+		return before, nil
+	case beforeValid:
+		return before, nil
+	case afterValid:
+		return after, nil
+	}
+	// the wall clock time is in the gap: the offset before the gap moves it forward, the offset after - backward
+	switch p.Nonexistent {
+	case ShiftBackward:
+		return after, nil
+	case NonexistentError:
+		return time.Time{}, fmt.Errorf("%w: %v %v in %v", ErrNonexistentTime, date, clock, loc)
+	}
+	return before, nil
+}
+
+// NewWallClockSpan initialization of a new time interval from the wall clock from to the wall clock to
+// of the date in the location. If to is before from the time interval ends on the next day.
+// Both wall clock times are resolved by WallClock with the policy.
+// Returns *ClockRangeError wrapping ErrClockOutOfRange or ErrEmpty if the range is invalid.
+func NewWallClockSpan(date Date, from, to time.Duration, loc *time.Location, policy ...WallClockPolicy) (Span, error) {
+	if err := validateClockRange(-1, from, to); err != nil {
+		return Span{}, err
+	}
+	start, err := WallClock(date, from, loc, policy...)
+	if err != nil {
+		return Span{}, err
+	}
+	endDate := date
+	if to < from {
+		endDate = date.AddDays(1)
+	}
+	end, err := WallClock(endDate, to, loc, policy...)
+	if err != nil {
+		return Span{}, err
+	}
+	return New(start, end)
+}
+
+// sameWallClock t shows the date and the wall clock of wall (given in UTC) in its location.
+func sameWallClock(t, wall time.Time) bool {
+	return DateOf(t) == DateOf(wall) && clockOf(t) == clockOf(wall)
+}
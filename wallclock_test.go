@@ -0,0 +1,170 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skip(err)
+	}
+	return loc
+}
+
+func TestWallClock(t *testing.T) {
+	newYork := loadLocation(t, "America/New_York")
+	// clocks go forward from 02:00 to 03:00 on March 10, 2024 and back from 02:00 to 01:00 on November 3, 2024
+	spring := NewDate(2024, time.March, 10)
+	autumn := NewDate(2024, time.November, 3)
+	testCases := []struct {
+		name   string
+		date   Date
+		clock  time.Duration
+		policy WallClockPolicy
+
+		excepted    time.Time
+		exceptedErr error
+	}{
+		{
+			name:     "regular",
+			date:     spring,
+			clock:    9 * time.Hour,
+			excepted: time.Date(2024, time.March, 10, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "nonexistent_shift_forward",
+			date:     spring,
+			clock:    2*time.Hour + 30*time.Minute,
+			excepted: time.Date(2024, time.March, 10, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "nonexistent_shift_backward",
+			date:     spring,
+			clock:    2*time.Hour + 30*time.Minute,
+			policy:   WallClockPolicy{Nonexistent: ShiftBackward},
+			excepted: time.Date(2024, time.March, 10, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:        "nonexistent_error",
+			date:        spring,
+			clock:       2 * time.Hour,
+			policy:      WallClockPolicy{Nonexistent: NonexistentError},
+			exceptedErr: ErrNonexistentTime,
+		},
+		{
+			name:     "ambiguous_earlier",
+			date:     autumn,
+			clock:    time.Hour + 30*time.Minute,
+			excepted: time.Date(2024, time.November, 3, 5, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "ambiguous_later",
+			date:     autumn,
+			clock:    time.Hour + 30*time.Minute,
+			policy:   WallClockPolicy{Ambiguous: Later},
+			excepted: time.Date(2024, time.November, 3, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:        "ambiguous_error",
+			date:        autumn,
+			clock:       time.Hour,
+			policy:      WallClockPolicy{Ambiguous: AmbiguousError},
+			exceptedErr: ErrAmbiguousTime,
+		},
+		{
+			name:     "after_transition",
+			date:     autumn,
+			clock:    2 * time.Hour,
+			policy:   WallClockPolicy{Ambiguous: AmbiguousError},
+			excepted: time.Date(2024, time.November, 3, 7, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := WallClock(tc.date, tc.clock, newYork, tc.policy)
+			if tc.exceptedErr != nil {
+				assert.True(t, errors.Is(err, tc.exceptedErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tc.excepted.Equal(result), result)
+			assert.Equal(t, newYork, result.Location())
+		})
+	}
+}
+
+func TestNewWallClockSpan(t *testing.T) {
+	newYork := loadLocation(t, "America/New_York")
+	spring := NewDate(2024, time.March, 10)
+
+	span, err := NewWallClockSpan(spring, 9*time.Hour, 17*time.Hour, newYork)
+	assert.NoError(t, err)
+	assert.Equal(t, 8*time.Hour, span.Duration())
+
+	span, err = NewWallClockSpan(spring, time.Hour, 3*time.Hour, newYork)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, span.Duration())
+
+	span, err = NewWallClockSpan(spring.AddDays(-1), 22*time.Hour, 6*time.Hour, newYork)
+	assert.NoError(t, err)
+	assert.Equal(t, 7*time.Hour, span.Duration())
+
+	// 02:00 shifted back is 01:00, the same as the start
+	_, err = NewWallClockSpan(spring, time.Hour, 2*time.Hour, newYork, WallClockPolicy{Nonexistent: ShiftBackward})
+	assert.True(t, errors.Is(err, ErrEmpty))
+
+	_, err = NewWallClockSpan(spring, time.Hour, 2*time.Hour+30*time.Minute, newYork, WallClockPolicy{Nonexistent: NonexistentError})
+	assert.True(t, errors.Is(err, ErrNonexistentTime))
+}
+
+func TestMixedLocations(t *testing.T) {
+	tokyo := time.FixedZone("UTC+9", 9*60*60)
+	utc := minuteSpan(0, 60)
+	local := minuteSpan(30, 90)
+	local = local.In(tokyo)
+
+	intersection := utc.Intersection(local)
+	assert.Equal(t, minuteSpan(30, 60), intersection)
+	intersection = local.Intersection(utc)
+	assert.Equal(t, time.UTC, utc.Start().Location())
+	assert.Equal(t, tokyo, intersection.Start().Location())
+	assert.Equal(t, tokyo, intersection.End().Location())
+
+	assert.Equal(t, NewMany(minuteSpan(0, 90)), utc.Union(local))
+	assert.Equal(t, NewMany(minuteSpan(0, 30)), utc.Except(local))
+
+	// time intervals in different locations give results in UTC whatever their order
+	many := NewMany(local, minuteSpan(100, 120))
+	union := many.Union(NewMany(utc))
+	assert.Equal(t, NewMany(minuteSpan(0, 90), minuteSpan(100, 120)), union)
+	reversed := NewMany(minuteSpan(100, 120), local)
+	assert.Equal(t, union, reversed.Union(NewMany(utc)))
+	assert.Equal(t, union, reversed.Union(NewMany(utc)))
+	assert.Equal(t, NewMany(minuteSpan(100, 120), local), reversed)
+
+	// time intervals in the same location keep it
+	later := minuteSpan(100, 120)
+	same := NewMany(later.In(tokyo), local)
+	excepted := NewMany(minuteSpan(30, 90), minuteSpan(100, 120))
+	assert.Equal(t, excepted.In(tokyo), same.Union())
+
+	input := minuteSpan(45, 60)
+	many = NewMany(minuteSpan(100, 120), local)
+	assert.Equal(t, NewMany(minuteSpan(45, 60)), many.Intersection(input.In(tokyo)))
+	many.Sort()
+	assert.Equal(t, NewMany(minuteSpan(45, 60)), many.Intersection(input.In(tokyo)))
+	input = minuteSpan(45, 100)
+	assert.Equal(t, NewMany(minuteSpan(30, 45), minuteSpan(100, 120)), many.Except(input.In(tokyo)))
+
+	empty := NewMany()
+	excepted = NewMany(minuteSpan(30, 90))
+	assert.Equal(t, excepted.In(tokyo), empty.Union(NewMany(local)))
+	empty = NewMany()
+	assert.Equal(t, NewMany(), empty.In(tokyo))
+}
@@ -0,0 +1,121 @@
+package timeinterval
+
+import "time"
+
+const (
+	dayLength  = 24 * time.Hour
+	weekLength = 7 * dayLength
+)
+
+// weekReference Sunday midnight, the beginning of the week for the time intervals of WeeklyTemplate.
+var weekReference = time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)
+
+// WeeklyTemplate recurring weekly schedule defined by the days of the week and wall clock time ranges,
+// for example business hours "Mon–Fri 09:00–18:00, Sat 10:00–14:00".
+type WeeklyTemplate struct {
+	// ranges sorted and merged time intervals within the week starting at weekReference.
+	ranges SpanMany
+}
+
+// NewWeeklyTemplate initialization of a new empty WeeklyTemplate.
+func NewWeeklyTemplate() WeeklyTemplate {
+	return WeeklyTemplate{
+		ranges: NewMany(),
+	}
+}
+
+// Add adding a wall clock time range to the day of the week.
+// from and to are the time elapsed since midnight, if to is not after from the range crosses midnight
+// and ends on the next day (22:00–06:00), the range crossing the end of Saturday continues on Sunday.
+// Returns *ClockRangeError wrapping ErrInvalidWeekday, ErrClockOutOfRange or ErrEmpty if the range is invalid.
+func (w *WeeklyTemplate) Add(weekday time.Weekday, from, to time.Duration) error {
+	if weekday < time.Sunday {
+		return &ClockRangeError{
+			Weekday: weekday,
+			Start:   from,
+			End:     to,
+			Err:     ErrInvalidWeekday,
+		}
+	}
+	if err := validateClockRange(weekday, from, to); err != nil {
+		return err
+	}
+	start := time.Duration(weekday)*dayLength + from
+	end := time.Duration(weekday)*dayLength + to
+	if to < from {
+		end += dayLength
+	}
+	ranges := NewMany(Span{
+		start: weekReference.Add(start),
+		end:   weekReference.Add(minDuration(end, weekLength)),
+	})
+	if end > weekLength {
+		ranges.AddMany(Span{
+			start: weekReference,
+			end:   weekReference.Add(end - weekLength),
+		})
+	}
+	w.ranges = Quorum(1, w.ranges, ranges)
+	return nil
+}
+
+// IsEmpty defines a template without time ranges.
+func (w *WeeklyTemplate) IsEmpty() bool {
+	return len(w.ranges.spans) == 0
+}
+
+// Union union of two weekly templates.
+func (w *WeeklyTemplate) Union(input WeeklyTemplate) WeeklyTemplate {
+	return WeeklyTemplate{
+		ranges: Quorum(1, w.ranges, input.ranges),
+	}
+}
+
+// Intersection intersection of two weekly templates.
+func (w *WeeklyTemplate) Intersection(input WeeklyTemplate) WeeklyTemplate {
+	return WeeklyTemplate{
+		ranges: Quorum(2, w.ranges, input.ranges),
+	}
+}
+
+// Except difference of weekly templates (w \ input).
+func (w *WeeklyTemplate) Except(input WeeklyTemplate) WeeklyTemplate {
+	return WeeklyTemplate{
+		ranges: w.ranges.exceptMany(input.ranges),
+	}
+}
+
+// Materialize time intervals of the template within the window in the location.
+// Wall clock times are converted on every day separately, so the time intervals follow
+// daylight saving time changes. The result is sorted, merged and clipped to the window.
+func (w *WeeklyTemplate) Materialize(window Span, loc *time.Location) SpanMany {
+	if w.IsEmpty() || !window.start.Before(window.end) {
+		return NewMany()
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	first := window.start.In(loc)
+	year, month, sunday := first.Date()
+	sunday -= int(first.Weekday())
+
+	result := NewMany()
+	for ; time.Date(year, month, sunday, 0, 0, 0, 0, loc).Before(window.end); sunday += 7 {
+		for _, r := range w.ranges.spans {
+			start := atClock(year, month, sunday, r.start.Sub(weekReference), loc)
+			end := atClock(year, month, sunday, r.end.Sub(weekReference), loc)
+			if start.Before(end) {
+				result.AddMany(Span{start: start, end: end})
+			}
+		}
+	}
+	result = result.Intersection(window)
+	return result.Union()
+}
+
+func minDuration(d1, d2 time.Duration) time.Duration {
+	if d1 < d2 {
+		return d1
+	}
+	return d2
+}
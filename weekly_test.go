@@ -0,0 +1,179 @@
+package timeinterval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type weeklyRange struct {
+	weekday  time.Weekday
+	from, to time.Duration
+}
+
+func newWeeklyTemplate(t *testing.T, ranges ...weeklyRange) WeeklyTemplate {
+	w := NewWeeklyTemplate()
+	for _, r := range ranges {
+		require.NoError(t, w.Add(r.weekday, r.from, r.to))
+	}
+	return w
+}
+
+func TestWeeklyTemplateAdd(t *testing.T) {
+	w := NewWeeklyTemplate()
+	testCases := []struct {
+		name     string
+		weekday  time.Weekday
+		from, to time.Duration
+		err      error
+	}{
+		{name: "weekday", weekday: time.Weekday(7), from: 9 * time.Hour, to: 18 * time.Hour, err: ErrInvalidWeekday},
+		{name: "weekday_negative", weekday: time.Weekday(-1), from: 9 * time.Hour, to: 18 * time.Hour, err: ErrInvalidWeekday},
+		{name: "negative", weekday: time.Monday, from: -time.Hour, to: 18 * time.Hour, err: ErrClockOutOfRange},
+		{name: "more_day", weekday: time.Monday, from: 9 * time.Hour, to: 25 * time.Hour, err: ErrClockOutOfRange},
+		{name: "equal", weekday: time.Monday, from: 9 * time.Hour, to: 9 * time.Hour, err: ErrEmpty},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := w.Add(tc.weekday, tc.from, tc.to)
+			var clockErr *ClockRangeError
+			assert.True(t, errors.As(err, &clockErr))
+			assert.True(t, errors.Is(err, tc.err))
+			assert.Equal(t, tc.weekday, clockErr.Weekday)
+			assert.Equal(t, tc.from, clockErr.Start)
+			assert.Equal(t, tc.to, clockErr.End)
+		})
+	}
+	err := w.Add(time.Weekday(7), 9*time.Hour, 18*time.Hour)
+	assert.Equal(t, "invalid wall clock range on day 7 9h0m0s - 18h0m0s: invalid day of the week", err.Error())
+	err = w.Add(time.Monday, 9*time.Hour, 9*time.Hour)
+	assert.Equal(t, "invalid wall clock range on day 1 9h0m0s - 9h0m0s: "+ErrEmpty.Error(), err.Error())
+	assert.True(t, w.IsEmpty())
+	assert.NoError(t, w.Add(time.Monday, 0, 24*time.Hour))
+	assert.False(t, w.IsEmpty())
+}
+
+func TestWeeklyTemplateMaterialize(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		template WeeklyTemplate
+		window   Span
+		loc      *time.Location
+
+		excepted SpanMany
+	}{
+		{
+			name: "business_hours",
+			template: newWeeklyTemplate(t,
+				weeklyRange{time.Monday, 9 * time.Hour, 18 * time.Hour},
+				weeklyRange{time.Tuesday, 9 * time.Hour, 18 * time.Hour},
+				weeklyRange{time.Wednesday, 9 * time.Hour, 18 * time.Hour},
+				weeklyRange{time.Saturday, 10 * time.Hour, 14 * time.Hour},
+			),
+			window: Span{
+				time.Date(2020, 10, 19, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, 10, 21, 12, 0, 0, 0, time.UTC)},
+			loc: time.UTC,
+			excepted: NewMany(
+				Span{
+					time.Date(2020, 10, 19, 9, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 19, 18, 0, 0, 0, time.UTC)},
+				Span{
+					time.Date(2020, 10, 20, 9, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 20, 18, 0, 0, 0, time.UTC)},
+				Span{
+					time.Date(2020, 10, 21, 9, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 21, 12, 0, 0, 0, time.UTC)},
+			),
+		},
+		{
+			name: "cross_midnight",
+			template: newWeeklyTemplate(t,
+				weeklyRange{time.Friday, 22 * time.Hour, 6 * time.Hour},
+				weeklyRange{time.Saturday, 22 * time.Hour, 2 * time.Hour},
+			),
+			window: Span{
+				time.Date(2020, 10, 18, 1, 0, 0, 0, time.UTC),
+				time.Date(2020, 10, 26, 0, 0, 0, 0, time.UTC)},
+			loc: time.UTC,
+			excepted: NewMany(
+				Span{
+					time.Date(2020, 10, 18, 1, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 18, 2, 0, 0, 0, time.UTC)},
+				Span{
+					time.Date(2020, 10, 23, 22, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 24, 6, 0, 0, 0, time.UTC)},
+				Span{
+					time.Date(2020, 10, 24, 22, 0, 0, 0, time.UTC),
+					time.Date(2020, 10, 25, 2, 0, 0, 0, time.UTC)},
+			),
+		},
+		{
+			name: "daylight_saving_time_end",
+			template: newWeeklyTemplate(t,
+				weeklyRange{time.Sunday, 0, 6 * time.Hour},
+			),
+			window: Span{
+				time.Date(2020, 10, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, 11, 2, 0, 0, 0, 0, time.UTC)},
+			loc: newYork,
+			excepted: NewMany(
+				Span{
+					time.Date(2020, 11, 1, 4, 0, 0, 0, time.UTC).In(newYork),
+					time.Date(2020, 11, 1, 11, 0, 0, 0, time.UTC).In(newYork)},
+			),
+		},
+		{
+			name:     "empty",
+			template: NewWeeklyTemplate(),
+			window: Span{
+				time.Date(2020, 10, 19, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, 10, 21, 12, 0, 0, 0, time.UTC)},
+			loc:      time.UTC,
+			excepted: NewMany(),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.template.Materialize(tc.window, tc.loc)
+			assert.Equal(t, len(tc.excepted.spans), len(result.spans))
+			for i := range result.spans {
+				assert.True(t, tc.excepted.spans[i].Equal(result.spans[i]), result.String())
+			}
+		})
+	}
+}
+
+func TestWeeklyTemplateSetOperations(t *testing.T) {
+	a := newWeeklyTemplate(t,
+		weeklyRange{time.Monday, 9 * time.Hour, 18 * time.Hour},
+		weeklyRange{time.Saturday, 22 * time.Hour, 2 * time.Hour},
+	)
+	b := newWeeklyTemplate(t,
+		weeklyRange{time.Monday, 12 * time.Hour, 20 * time.Hour},
+		weeklyRange{time.Tuesday, 9 * time.Hour, 10 * time.Hour},
+		weeklyRange{time.Sunday, 1 * time.Hour, 3 * time.Hour},
+	)
+
+	assert.Equal(t, newWeeklyTemplate(t,
+		weeklyRange{time.Monday, 9 * time.Hour, 20 * time.Hour},
+		weeklyRange{time.Tuesday, 9 * time.Hour, 10 * time.Hour},
+		weeklyRange{time.Saturday, 22 * time.Hour, 3 * time.Hour},
+	), a.Union(b))
+	assert.Equal(t, newWeeklyTemplate(t,
+		weeklyRange{time.Monday, 12 * time.Hour, 18 * time.Hour},
+		weeklyRange{time.Sunday, 1 * time.Hour, 2 * time.Hour},
+	), a.Intersection(b))
+	assert.Equal(t, newWeeklyTemplate(t,
+		weeklyRange{time.Monday, 9 * time.Hour, 12 * time.Hour},
+		weeklyRange{time.Saturday, 22 * time.Hour, 1 * time.Hour},
+	), a.Except(b))
+}
@@ -0,0 +1,251 @@
+package timeinterval
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// windowEpoch beginning of counting fixed size windows.
+var windowEpoch = time.Unix(0, 0).UTC()
+
+// WindowAssigner assigning instants and time intervals to the windows of stream processing.
+type WindowAssigner interface {
+	// Assign windows containing the instant, sorted ascending.
+	Assign(t time.Time) SpanMany
+	// AssignSpan windows intersecting the time interval, sorted ascending.
+	AssignSpan(s Span) SpanMany
+}
+
+// TumblingWindows fixed size windows following each other without gaps,
+// aligned to the Unix epoch shifted by the offset.
+type TumblingWindows struct {
+	size   time.Duration
+	offset time.Duration
+}
+
+// NewTumblingWindows initialization of tumbling windows of the size.
+
+// offset - shift of the windows from the Unix epoch, for example -3h for days starting at 00:00 UTC+3.
+func NewTumblingWindows(size time.Duration, offset ...time.Duration) (*TumblingWindows, error) {
+	if size <= 0 {
+		return nil, errors.New("window size must be positive")
+	}
+	w := &TumblingWindows{size: size}
+	if len(offset) > 0 {
+		w.offset = offset[0] % size
+	}
+	return w, nil
+}
+
+// Assign the window containing the instant.
+func (w *TumblingWindows) Assign(t time.Time) SpanMany {
+	start := windowFloor(t, w.size, w.offset)
+	return NewMany(Span{start: start, end: start.Add(w.size)})
+}
+
+// AssignSpan windows intersecting the time interval.
+func (w *TumblingWindows) AssignSpan(s Span) SpanMany {
+	result := NewMany()
+	if !s.start.Before(s.end) {
+		return result
+	}
+	for start := windowFloor(s.start, w.size, w.offset); start.Before(s.end); start = start.Add(w.size) {
+		result.spans = append(result.spans, Span{start: start, end: start.Add(w.size)})
+	}
+	return result
+}
+
+// HoppingWindows fixed size windows starting every slide, they overlap if slide is less than size.
+type HoppingWindows struct {
+	size   time.Duration
+	slide  time.Duration
+	offset time.Duration
+}
+
+// NewHoppingWindows initialization of hopping windows of the size starting every slide.
+
+// offset - shift of the windows from the Unix epoch.
+func NewHoppingWindows(size, slide time.Duration, offset ...time.Duration) (*HoppingWindows, error) {
+	if size <= 0 || slide <= 0 {
+		return nil, errors.New("window size and slide must be positive")
+	}
+	w := &HoppingWindows{size: size, slide: slide}
+	if len(offset) > 0 {
+		w.offset = offset[0] % slide
+	}
+	return w, nil
+}
+
+// Assign windows containing the instant.
+func (w *HoppingWindows) Assign(t time.Time) SpanMany {
+	return w.AssignSpan(Span{start: t, end: t.Add(1)})
+}
+
+// AssignSpan windows intersecting the time interval.
+func (w *HoppingWindows) AssignSpan(s Span) SpanMany {
+	result := NewMany()
+	if !s.start.Before(s.end) {
+		return result
+	}
+	first := windowFloor(s.start, w.slide, w.offset)
+	for first.Add(-w.slide).Add(w.size).After(s.start) {
+		first = first.Add(-w.slide)
+	}
+	for start := first; start.Before(s.end); start = start.Add(w.slide) {
+		if start.Add(w.size).After(s.start) {
+			result.spans = append(result.spans, Span{start: start, end: start.Add(w.size)})
+		}
+	}
+	return result
+}
+
+// CalendarWindows windows of calendar units (days, months...) in the location.
+type CalendarWindows struct {
+	unit Unit
+	loc  *time.Location
+}
+
+// NewCalendarWindows initialization of calendar windows of the unit in the location.
+func NewCalendarWindows(unit Unit, loc *time.Location) *CalendarWindows {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &CalendarWindows{unit: unit, loc: loc}
+}
+
+// Assign the calendar unit containing the instant.
+func (w *CalendarWindows) Assign(t time.Time) SpanMany {
+	start := unitStart(t, w.unit, w.loc)
+	return NewMany(Span{start: start, end: unitAdd(start, w.unit, 1)})
+}
+
+// AssignSpan calendar units intersecting the time interval.
+func (w *CalendarWindows) AssignSpan(s Span) SpanMany {
+	result := NewMany()
+	if !s.start.Before(s.end) {
+		return result
+	}
+	for start := unitStart(s.start, w.unit, w.loc); start.Before(s.end); start = unitAdd(start, w.unit, 1) {
+		result.spans = append(result.spans, Span{start: start, end: unitAdd(start, w.unit, 1)})
+	}
+	return result
+}
+
+// WindowAggregate aggregate of the values of one window.
+type WindowAggregate struct {
+	Window Span
+	Count  int
+	Sum    float64
+	Min    float64
+	Max    float64
+}
+
+// windowKey identifier of a window in WindowAccumulator.
+type windowKey struct {
+	start int64
+	end   int64
+}
+
+// WindowAccumulator aggregating values by windows, a window is emitted once the watermark
+// has passed its end plus the allowed lateness.
+type WindowAccumulator struct {
+	assigner  WindowAssigner
+	lateness  time.Duration
+	watermark time.Time
+	windows   map[windowKey]*WindowAggregate
+}
+
+// NewWindowAccumulator initialization of a new WindowAccumulator.
+
+// allowedLateness - how long after the end of a window late values are still accepted.
+func NewWindowAccumulator(assigner WindowAssigner, allowedLateness ...time.Duration) *WindowAccumulator {
+	a := &WindowAccumulator{
+		assigner: assigner,
+		windows:  make(map[windowKey]*WindowAggregate),
+	}
+	if len(allowedLateness) > 0 && allowedLateness[0] > 0 {
+		a.lateness = allowedLateness[0]
+	}
+	return a
+}
+
+// Add adding the value with its timestamp to all its windows.
+// Returns false if all windows of the value are already closed and the value is dropped.
+func (a *WindowAccumulator) Add(t time.Time, value float64) bool {
+	added := false
+	windows := a.assigner.Assign(t)
+	for _, w := range windows.spans {
+		if a.isClosed(w) {
+			continue
+		}
+		added = true
+		key := windowKey{start: w.start.UnixNano(), end: w.end.UnixNano()}
+		agg, ok := a.windows[key]
+		if !ok {
+			a.windows[key] = &WindowAggregate{Window: w, Count: 1, Sum: value, Min: value, Max: value}
+			continue
+		}
+		agg.Count++
+		agg.Sum += value
+		if value < agg.Min {
+			agg.Min = value
+		}
+		if value > agg.Max {
+			agg.Max = value
+		}
+	}
+	return added
+}
+
+// Advance moving the watermark forward and emitting the closed windows sorted ascending.
+// The watermark never moves back.
+func (a *WindowAccumulator) Advance(watermark time.Time) []WindowAggregate {
+	if watermark.After(a.watermark) {
+		a.watermark = watermark
+	}
+	return a.emit(false)
+}
+
+// Flush emitting all windows regardless of the watermark, for example at the end of the stream.
+func (a *WindowAccumulator) Flush() []WindowAggregate {
+	return a.emit(true)
+}
+
+// Watermark the current watermark.
+func (a *WindowAccumulator) Watermark() time.Time {
+	return a.watermark
+}
+
+func (a *WindowAccumulator) emit(all bool) []WindowAggregate {
+	var result []WindowAggregate
+	for key, agg := range a.windows {
+		if !all && !a.isClosed(agg.Window) {
+			continue
+		}
+		result = append(result, *agg)
+		delete(a.windows, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Window.start.Equal(result[j].Window.start) {
+			return result[i].Window.end.Before(result[j].Window.end)
+		}
+		return result[i].Window.start.Before(result[j].Window.start)
+	})
+	return result
+}
+
+func (a *WindowAccumulator) isClosed(w Span) bool {
+	return !a.watermark.IsZero() && !w.end.Add(a.lateness).After(a.watermark)
+}
+
+// windowFloor the beginning of the fixed size window containing t.
+func windowFloor(t time.Time, size, offset time.Duration) time.Time {
+	origin := windowEpoch.Add(offset)
+	d := t.Sub(origin)
+	n := d / size
+	if d%size < 0 {
+		n--
+	}
+	return origin.Add(n * size).In(t.Location())
+}
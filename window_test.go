@@ -0,0 +1,123 @@
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTumblingWindows(t *testing.T) {
+	_, err := NewTumblingWindows(0)
+	assert.Error(t, err)
+
+	w, err := NewTumblingWindows(time.Hour, 15*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, NewMany(Span{
+		time.Date(2020, 10, 19, 9, 15, 0, 0, time.UTC),
+		time.Date(2020, 10, 19, 10, 15, 0, 0, time.UTC),
+	}), w.Assign(time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC)))
+	assert.Equal(t, NewMany(Span{
+		time.Date(1969, 12, 31, 23, 15, 0, 0, time.UTC),
+		time.Date(1970, 1, 1, 0, 15, 0, 0, time.UTC),
+	}), w.Assign(time.Date(1969, 12, 31, 23, 30, 0, 0, time.UTC)))
+
+	assert.Equal(t, NewMany(
+		Span{
+			time.Date(2020, 10, 19, 9, 15, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 10, 15, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 10, 19, 10, 15, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 11, 15, 0, 0, time.UTC)},
+	), w.AssignSpan(Span{
+		time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC),
+		time.Date(2020, 10, 19, 11, 15, 0, 0, time.UTC),
+	}))
+	assert.Equal(t, NewMany(), w.AssignSpan(Span{}))
+}
+
+func TestHoppingWindows(t *testing.T) {
+	_, err := NewHoppingWindows(time.Hour, 0)
+	assert.Error(t, err)
+
+	w, err := NewHoppingWindows(time.Hour, 20*time.Minute)
+	require.NoError(t, err)
+	window := func(hour, min int) Span {
+		start := time.Date(2020, 10, 19, hour, min, 0, 0, time.UTC)
+		return Span{start, start.Add(time.Hour)}
+	}
+	assert.Equal(t, NewMany(window(9, 20), window(9, 40), window(10, 0)),
+		w.Assign(time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC)))
+	assert.Equal(t, NewMany(window(9, 20), window(9, 40), window(10, 0), window(10, 20)),
+		w.AssignSpan(Span{
+			time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 19, 10, 30, 0, 0, time.UTC),
+		}))
+
+	gaps, err := NewHoppingWindows(10*time.Minute, 30*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, NewMany(), gaps.Assign(time.Date(2020, 10, 19, 10, 15, 0, 0, time.UTC)))
+}
+
+func TestCalendarWindows(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	w := NewCalendarWindows(UnitDay, newYork)
+
+	result := w.Assign(time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC))
+	require.Len(t, result.Spans(), 1)
+	day := result.Spans()[0]
+	assert.True(t, day.Start().Equal(time.Date(2020, 11, 1, 0, 0, 0, 0, newYork)))
+	assert.Equal(t, 25*time.Hour, day.Duration())
+
+	months := NewCalendarWindows(UnitMonth, nil)
+	assert.Equal(t, NewMany(
+		Span{
+			time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)},
+		Span{
+			time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)},
+	), months.AssignSpan(Span{
+		time.Date(2020, 9, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 10, 2, 0, 0, 0, 0, time.UTC),
+	}))
+}
+
+func TestWindowAccumulator(t *testing.T) {
+	w, err := NewTumblingWindows(10 * time.Minute)
+	require.NoError(t, err)
+	a := NewWindowAccumulator(w, 2*time.Minute)
+	at := func(m int) time.Time {
+		return minutes(m)[0]
+	}
+
+	assert.True(t, a.Add(at(1), 1))
+	assert.True(t, a.Add(at(5), 3))
+	assert.True(t, a.Add(at(12), 10))
+	assert.Empty(t, a.Advance(at(11)))
+
+	// late but within the allowed lateness
+	assert.True(t, a.Add(at(9), -2))
+	result := a.Advance(at(12))
+	assert.Equal(t, []WindowAggregate{{
+		Window: minuteSpan(0, 10),
+		Count:  3,
+		Sum:    2,
+		Min:    -2,
+		Max:    3,
+	}}, result)
+	assert.False(t, a.Add(at(8), 100))
+
+	// the watermark does not move back
+	assert.Empty(t, a.Advance(at(1)))
+	assert.Equal(t, at(12), a.Watermark())
+
+	assert.True(t, a.Add(at(25), 5))
+	assert.Equal(t, []WindowAggregate{
+		{Window: minuteSpan(10, 20), Count: 1, Sum: 10, Min: 10, Max: 10},
+		{Window: minuteSpan(20, 30), Count: 1, Sum: 5, Min: 5, Max: 5},
+	}, a.Flush())
+	assert.Empty(t, a.Flush())
+}